@@ -0,0 +1,141 @@
+package discordemojimap
+
+import (
+	"sort"
+	"sync"
+)
+
+// Usage is the amount of times an emoji has been used.
+type Usage struct {
+	// Emoji is the unicode emoji, as found in EmojiMap. It is empty for
+	// custom emoji.
+	Emoji string `json:"emoji,omitempty"`
+	// Code is the primary code of the unicode emoji, or the name of the
+	// custom emoji.
+	Code string `json:"code"`
+	// Custom is only set for custom emoji. Custom emoji are told apart by
+	// their ID, the name is the one seen most recently.
+	Custom *CustomEmoji `json:"custom,omitempty"`
+	Count  int          `json:"count"`
+}
+
+// AggregatorSnapshot is the state of an Aggregator at a certain point in
+// time.
+type AggregatorSnapshot struct {
+	// Messages is the amount of messages that have been added.
+	Messages int `json:"messages"`
+	// Usages contains all emoji that have been used, sorted by count in
+	// descending order.
+	Usages []Usage `json:"usages"`
+}
+
+// Aggregator counts emoji usage across messages. It is safe for concurrent
+// use. The zero value is ready to use.
+type Aggregator struct {
+	// NormalizeSkinTones counts emoji with skin tones as the emoji without
+	// skin tones, so "👍🏻" and ":thumbsup_tone5:" both count as "👍". It must
+	// not be changed after the first message has been added.
+	NormalizeSkinTones bool
+
+	mutex    sync.Mutex
+	messages int
+	usages   map[string]*Usage
+}
+
+// Add counts all emoji in the message. The message may contain codes,
+// unicode emoji and custom emoji.
+func (aggregator *Aggregator) Add(message string) {
+	tokens := Tokenize(message)
+
+	aggregator.mutex.Lock()
+	defer aggregator.mutex.Unlock()
+
+	if aggregator.usages == nil {
+		aggregator.usages = make(map[string]*Usage)
+	}
+	aggregator.messages++
+	for _, token := range tokens {
+		switch token.Kind {
+		case EmojiToken, CodeToken:
+			emoji := token.Emoji
+			if aggregator.NormalizeSkinTones {
+				emoji = baseEmoji(emoji)
+			}
+			usage := aggregator.usages[emoji]
+			if usage == nil {
+				usage = &Usage{Emoji: emoji, Code: codesByEmoji[emoji][0]}
+				aggregator.usages[emoji] = usage
+			}
+			usage.Count++
+		case CustomEmojiToken:
+			// Prefixed, so IDs can't collide with unicode emoji.
+			key := "<" + token.Custom.ID
+			usage := aggregator.usages[key]
+			if usage == nil {
+				usage = &Usage{}
+				aggregator.usages[key] = usage
+			}
+			custom := token.Custom
+			usage.Custom = &custom
+			usage.Code = custom.Name
+			usage.Count++
+		}
+	}
+}
+
+// Top returns the n most used emoji, sorted by count in descending order.
+// Emoji with the same count are sorted by code, followed by custom emoji
+// with the same name. If n is 0 or less, all emoji are returned.
+func (aggregator *Aggregator) Top(n int) []Usage {
+	usages := aggregator.Snapshot().Usages
+	if n > 0 && n < len(usages) {
+		usages = usages[:n]
+	}
+	return usages
+}
+
+// Snapshot returns a copy of the current state. The snapshot can be
+// serialised to JSON.
+func (aggregator *Aggregator) Snapshot() AggregatorSnapshot {
+	aggregator.mutex.Lock()
+	snapshot := AggregatorSnapshot{
+		Messages: aggregator.messages,
+		Usages:   make([]Usage, 0, len(aggregator.usages)),
+	}
+	for _, usage := range aggregator.usages {
+		usageCopy := *usage
+		if usage.Custom != nil {
+			custom := *usage.Custom
+			usageCopy.Custom = &custom
+		}
+		snapshot.Usages = append(snapshot.Usages, usageCopy)
+	}
+	aggregator.mutex.Unlock()
+
+	sort.Slice(snapshot.Usages, func(a, b int) bool {
+		usageA, usageB := snapshot.Usages[a], snapshot.Usages[b]
+		if usageA.Count != usageB.Count {
+			return usageA.Count > usageB.Count
+		}
+		if usageA.Code != usageB.Code {
+			return usageA.Code < usageB.Code
+		}
+		// Custom emoji can share a name with each other or with the code
+		// of a unicode emoji. Unicode emoji come first, custom emoji are
+		// sorted by ID.
+		if (usageA.Custom == nil) != (usageB.Custom == nil) {
+			return usageA.Custom == nil
+		}
+		return usageA.Custom != nil && usageA.Custom.ID < usageB.Custom.ID
+	})
+	return snapshot
+}
+
+// Reset removes all counts.
+func (aggregator *Aggregator) Reset() {
+	aggregator.mutex.Lock()
+	defer aggregator.mutex.Unlock()
+
+	aggregator.messages = 0
+	aggregator.usages = nil
+}
@@ -0,0 +1,104 @@
+package discordemojimap
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestAggregator(t *testing.T) {
+	t.Parallel()
+
+	var aggregator Aggregator
+	var wait sync.WaitGroup
+	for range 50 {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			aggregator.Add("I am :cry: 😢 👍🏻")
+			aggregator.Add("<:pepe:123> <a:pepe_dance:123> 👍")
+		}()
+	}
+	wait.Wait()
+
+	want := []Usage{
+		{Emoji: "😢", Code: "cry", Count: 100},
+		{Code: "pepe_dance", Custom: &CustomEmoji{Name: "pepe_dance", ID: "123", Animated: true}, Count: 100},
+		{Emoji: "👍", Code: "thumbsup", Count: 50},
+	}
+	if got := aggregator.Top(3); !reflect.DeepEqual(got, want) {
+		t.Errorf("Top(3) = %+v, want %+v", got, want)
+	}
+
+	snapshot := aggregator.Snapshot()
+	if snapshot.Messages != 100 || len(snapshot.Usages) != 4 {
+		t.Errorf("Snapshot() = %+v, want 100 messages and 4 usages", snapshot)
+	}
+
+	aggregator.Reset()
+	if got := aggregator.Snapshot(); got.Messages != 0 || len(got.Usages) != 0 {
+		t.Errorf("Snapshot() after Reset() = %+v", got)
+	}
+}
+
+func TestAggregatorOrderIsStable(t *testing.T) {
+	t.Parallel()
+
+	var aggregator Aggregator
+	aggregator.Add("<:cry:2> :cry: <:cry:1> <:cry:10>")
+
+	want := []Usage{
+		{Emoji: "😢", Code: "cry", Count: 1},
+		{Code: "cry", Custom: &CustomEmoji{Name: "cry", ID: "1"}, Count: 1},
+		{Code: "cry", Custom: &CustomEmoji{Name: "cry", ID: "10"}, Count: 1},
+		{Code: "cry", Custom: &CustomEmoji{Name: "cry", ID: "2"}, Count: 1},
+	}
+	for range 20 {
+		if got := aggregator.Top(0); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Top(0) = %+v, want %+v", got, want)
+		}
+	}
+}
+
+func TestAggregatorNormalizeSkinTones(t *testing.T) {
+	t.Parallel()
+
+	aggregator := Aggregator{NormalizeSkinTones: true}
+	aggregator.Add("👍 👍🏻 :thumbsup_tone5: 🫱🏻‍🫲🏿")
+
+	want := []Usage{
+		{Emoji: "👍", Code: "thumbsup", Count: 3},
+		{Emoji: "🤝", Code: "handshake", Count: 1},
+	}
+	if got := aggregator.Top(0); !reflect.DeepEqual(got, want) {
+		t.Errorf("Top(0) = %+v, want %+v", got, want)
+	}
+}
+
+func TestAggregatorSnapshotJSON(t *testing.T) {
+	t.Parallel()
+
+	var aggregator Aggregator
+	aggregator.Add(":cry: <:pepe:123>")
+
+	data, err := json.Marshal(aggregator.Snapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"messages":1,"usages":[{"emoji":"😢","code":"cry","count":1},{"code":"pepe","custom":{"name":"pepe","id":"123"},"count":1}]}`
+	if string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+}
+
+func ExampleAggregator() {
+	var aggregator Aggregator
+	aggregator.Add("Good morning :wave:")
+	aggregator.Add("👋 😀")
+	for _, usage := range aggregator.Top(1) {
+		fmt.Println(usage.Emoji, usage.Count)
+	}
+	// Output: 👋 2
+}
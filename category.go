@@ -0,0 +1,34 @@
+package discordemojimap
+
+// Category is the category an emoji is listed in by Discord's emoji picker.
+type Category string
+
+// These are the categories of Discord's emoji picker.
+const (
+	CategoryPeople   Category = "people"
+	CategoryNature   Category = "nature"
+	CategoryFood     Category = "food"
+	CategoryActivity Category = "activity"
+	CategoryTravel   Category = "travel"
+	CategoryObjects  Category = "objects"
+	CategorySymbols  Category = "symbols"
+	CategoryFlags    Category = "flags"
+)
+
+// GetCategory returns the category of an emoji. Emoji without variation
+// selector are found as well. If the emoji isn't known, an empty string is
+// returned.
+func GetCategory(emoji string) Category {
+	canonical, exists := emojiIndexLookup(emoji)
+	if !exists {
+		return ""
+	}
+	return emojiCategories[canonical]
+}
+
+// GetCodeCategory returns the category of the emoji the code is mapped to.
+// The search is case-insensitive. If the code isn't known, an empty string
+// is returned.
+func GetCodeCategory(emojiCode string) Category {
+	return emojiCategories[GetEmoji(emojiCode)]
+}
@@ -0,0 +1,52 @@
+package discordemojimap
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGetCategory(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		emoji string
+		want  Category
+	}{
+		{name: "people", emoji: "😢", want: CategoryPeople},
+		{name: "skin tone", emoji: "👍🏽", want: CategoryPeople},
+		{name: "nature", emoji: "🐶", want: CategoryNature},
+		{name: "food", emoji: "🍏", want: CategoryFood},
+		{name: "activity", emoji: "⚽", want: CategoryActivity},
+		{name: "travel", emoji: "🚗", want: CategoryTravel},
+		{name: "objects", emoji: "⌚", want: CategoryObjects},
+		{name: "symbols", emoji: "❤️", want: CategorySymbols},
+		{name: "without variation selector", emoji: "❤", want: CategorySymbols},
+		{name: "flags", emoji: "🇩🇪", want: CategoryFlags},
+		{name: "unknown", emoji: "a", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := GetCategory(tt.emoji); got != tt.want {
+				t.Errorf("GetCategory(%q) = %q, want %q", tt.emoji, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEveryEmojiHasCategory(t *testing.T) {
+	t.Parallel()
+
+	for code, emoji := range EmojiMap {
+		if GetCategory(emoji) == "" {
+			t.Errorf("emoji %q for code %q has no category", emoji, code)
+		}
+	}
+}
+
+func ExampleGetCodeCategory() {
+	fmt.Println(GetCodeCategory("flag_de"))
+	// Output: flags
+}
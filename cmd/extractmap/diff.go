@@ -0,0 +1,74 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Bios-Marcel/discordemojimap/v2/emojidata"
+)
+
+// diffCommand compares Discord's asset with the current mapping and reports
+// added, removed, renamed and repointed codes.
+func diffCommand(args []string) error {
+	flags := flag.NewFlagSet(filepath.Base(os.Args[0])+" diff", flag.ContinueOnError)
+	path := ""
+	flags.StringVar(&path, "path", "", "path to Discord's asset to compare, or to a directory of JavaScript bundles to search.")
+	against := "mapping.go"
+	flags.StringVar(&against, "against", against, "the previous mapping, either generated Go code or a previous Discord asset.")
+	asJSON := false
+	flags.BoolVar(&asJSON, "json", false, "write the diff as JSON instead of one change per line.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if path == "" {
+		fmt.Fprintln(flags.Output(), "Usage:", flags.Name(), "-path <file> [-against <file>] [-json]")
+		flags.PrintDefaults()
+		return errUsage
+	}
+
+	dataset, err := parseAssetFile(path)
+	if err != nil {
+		return err
+	}
+	previous, err := parseMappingFile(against)
+	if err != nil {
+		return err
+	}
+
+	diff := emojidata.DiffMappings(previous, dataset.Mapping())
+	if asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "\t")
+		return encoder.Encode(diff)
+	}
+	return diff.WriteText(os.Stdout)
+}
+
+// parseMappingFile parses the codes of a previous mapping. Files ending in
+// ".go" are parsed as generated Go code, everything else as Discord asset.
+func parseMappingFile(path string) (map[string]string, error) {
+	if !strings.HasSuffix(path, ".go") {
+		dataset, err := parseAssetFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return dataset.Mapping(), nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading previous mapping: %w", err)
+	}
+	defer file.Close()
+
+	mapping, err := emojidata.ParseGoMapping(file)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing previous mapping %q: %w", path, err)
+	}
+	return mapping, nil
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path"
+	"path/filepath"
+
+	"github.com/Bios-Marcel/discordemojimap/v2/emojidata"
+)
+
+// fetchCommand downloads the asset containing the emoji data, so it can be
+// passed to the other commands.
+func fetchCommand(args []string) error {
+	flags := flag.NewFlagSet(filepath.Base(os.Args[0])+" fetch", flag.ContinueOnError)
+	baseURL := emojidata.DefaultBaseURL
+	flags.StringVar(&baseURL, "base-url", baseURL, "URL of Discord's web app.")
+	out := ""
+	flags.StringVar(&out, "out", "", "path to save the asset to, defaults to the asset's filename.")
+	maxAssets := emojidata.DefaultMaxAssets
+	flags.IntVar(&maxAssets, "max-assets", maxAssets, "maximum amount of assets to download while searching.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	fetcher := emojidata.Fetcher{BaseURL: baseURL, MaxAssets: maxAssets}
+	result, err := fetcher.Fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		out = path.Base(result.URL)
+	}
+	if err := os.WriteFile(out, result.Asset, 0o644); err != nil {
+		return fmt.Errorf("failed to save asset: %w", err)
+	}
+	fmt.Fprintln(os.Stderr, "Saved", result.URL, "to", out)
+	return nil
+}
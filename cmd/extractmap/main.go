@@ -1,133 +1,161 @@
 package main
 
 import (
-	"bytes"
-	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
-	"io"
-	"io/ioutil"
-	"log"
 	"os"
 	"path/filepath"
-	"regexp"
-	"sort"
-	"strings"
-)
-
-// USE FILE:
-// https://discord.com/assets/b38205c8085075585265.js
-
-const goCode = `package discordemojimap
 
-// This file is auto generated: DO NOT EDIT.
-
-var EmojiMap = map[string]string {
-%s}
-`
-
-// emojiJSONRegex matches the emoji JSON in a certain asset file. This JSON can
-// have one of its first object key as one of the strings matched in this OR'd
-// regex.
-var emojiJSONRegex = regexp.MustCompile(`'{"(people|activity|flags|food|nature|objects|symbols|travel)":.*}'`)
+	"github.com/Bios-Marcel/discordemojimap/v2/emojidata"
+)
 
-type EmojiGroups map[string][]Emoji
+func main() {
+	var err error
+	switch {
+	case len(os.Args) > 1 && os.Args[1] == "diff":
+		err = diffCommand(os.Args[2:])
+	case len(os.Args) > 1 && os.Args[1] == "fetch":
+		err = fetchCommand(os.Args[2:])
+	default:
+		err = generateCommand(os.Args[1:])
+	}
 
-func (eg EmojiGroups) GroupNames() []string {
-	keys := make([]string, 0, len(eg))
-	for key := range eg {
-		keys = append(keys, key)
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
 	}
-	return keys
 }
 
-type Emoji struct {
-	Names          []string `json:"names"`
-	Surrogates     string   `json:"surrogates"` // the emoji
-	UnicodeVersion float64  `json:"unicodeVersion"`
+// errUsage is returned by commands if a required flag is missing. The usage
+// has already been printed in that case.
+var errUsage = flag.ErrHelp
 
-	// Optionals
+// generateCommand generates mapping.go from Discord's asset.
+func generateCommand(args []string) error {
+	flags := flag.NewFlagSet(filepath.Base(os.Args[0]), flag.ContinueOnError)
+	path := ""
+	flags.StringVar(&path, "path", "", "path should be a relative or absolute path to the file to create the mapping from, or to a directory of JavaScript bundles to search.")
+	out := ""
+	flags.StringVar(&out, "out", "", "output file path, defaults to mapping.go for the go format and emoji.<format> otherwise.")
+	format := string(emojidata.FormatGo)
+	flags.StringVar(&format, "format", format, "output format, one of go, json, csv or ts.")
+	emojiTestPath := ""
+	flags.StringVar(&emojiTestPath, "emoji-test", "", "optional path to Unicode's emoji-test.txt, used to cross-check the mapping and to add CLDR names and emoji versions.")
+	annotationsPath := ""
+	flags.StringVar(&annotationsPath, "annotations", "", "optional path to a CLDR annotations file, such as common/annotations/en.xml, used to add keywords to the JSON, CSV and TypeScript output.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
 
-	HasDiversity      bool    `json:"hasDiversity,omitempty"`
-	HasMultiDiversity bool    `json:"hasMultiDiversity,omitempty"`
-	Diversities       []Emoji `json:"diversityChildren,omitempty"`
-}
+	if path == "" {
+		fmt.Fprintln(flags.Output(), "Usage:", filepath.Base(os.Args[0]), "[diff] -path <file>, or", filepath.Base(os.Args[0]), "fetch")
+		flags.PrintDefaults()
+		return errUsage
+	}
 
-// GoSyntax writes the representation of the emoji as a single map entry.
-func (e Emoji) GoSyntax(w io.Writer) (n int, err error) {
-	for _, emojiName := range e.Names {
-		// Use %+q so Unicode emojis are formatted as \U or \u.
-		w, err := fmt.Fprintf(w, "\t%q: %+q,\n", emojiName, e.Surrogates)
-		n += w // accumulate bytes written
-		if err != nil {
-			return n, err
+	if out == "" {
+		out = "emoji." + format
+		if emojidata.Format(format) == emojidata.FormatGo {
+			out = "mapping.go"
 		}
 	}
 
-	return n, nil
+	return run(path, out, emojidata.Format(format), emojiTestPath, annotationsPath)
 }
 
-func main() {
-	path := ""
-	flag.StringVar(&path, "path", "", "path should be a relative or absolute path to the file to create the mapping from.")
-	out := "mapping.go"
-	flag.StringVar(&out, "out", out, "output file path")
-
-	flag.Parse()
-
-	if path == "" {
-		log.Fatalln("Usage:", filepath.Base(os.Args[0]), "-path <file>")
+func run(path, out string, format emojidata.Format, emojiTestPath, annotationsPath string) error {
+	switch format {
+	case emojidata.FormatGo, emojidata.FormatJSON, emojidata.FormatCSV, emojidata.FormatTypeScript:
+	default:
+		return fmt.Errorf("unknown format %q", format)
 	}
 
-	absPath, absPathError := filepath.Abs(path)
-	if absPathError != nil {
-		log.Fatalf("Error retrieving absolute filepath for input: %q: %s\n", path, absPathError)
+	dataset, err := parseAssetFile(path)
+	if err != nil {
+		return err
 	}
-
-	data, readError := ioutil.ReadFile(absPath)
-	if readError != nil {
-		log.Fatalf("Error reading data: %s\n", readError)
+	// Validate before opening the output, so a broken dataset doesn't
+	// replace a working mapping.
+	if err := emojidata.Validate(dataset); err != nil {
+		return err
 	}
 
-	emojiJSON := emojiJSONRegex.Find(data)
-	if emojiJSON == nil {
-		log.Fatalln("Emojis JSON not found.")
-	}
-
-	// Trim the single quotes matched.
-	emojiJSON = bytes.Trim(emojiJSON, "'")
+	if emojiTestPath != "" {
+		emojiTest, err := os.Open(emojiTestPath)
+		if err != nil {
+			return fmt.Errorf("failed to open emoji-test.txt: %w", err)
+		}
+		entries, err := emojidata.ParseEmojiTest(emojiTest)
+		emojiTest.Close()
+		if err != nil {
+			return fmt.Errorf("failed to parse emoji-test.txt: %w", err)
+		}
 
-	var groups EmojiGroups
+		names, mismatches := emojidata.CrossCheck(dataset, entries)
+		for _, mismatch := range mismatches {
+			fmt.Fprintln(os.Stderr, mismatch)
+		}
+		dataset.Names = names
+		dataset.Versions = emojidata.EmojiTestVersions(entries)
+	}
 
-	if err := json.Unmarshal(emojiJSON, &groups); err != nil {
-		log.Fatalln("Failed to unmarshal JSON:", err)
+	if annotationsPath != "" {
+		annotations, err := os.Open(annotationsPath)
+		if err != nil {
+			return fmt.Errorf("failed to open annotations: %w", err)
+		}
+		dataset.Keywords, err = emojidata.ParseAnnotations(annotations)
+		annotations.Close()
+		if err != nil {
+			return fmt.Errorf("failed to parse annotations: %w", err)
+		}
 	}
 
-	// Even though maps are unordered, we'd preferably still want a reproducible
-	// output.
-	names := groups.GroupNames()
-	sort.Strings(names)
+	f, err := os.OpenFile(out, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open output: %w", err)
+	}
+	if err := emojidata.Write(f, dataset, format, emojidata.GoOptions{}); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write %s: %w", format, err)
+	}
+	return f.Close()
+}
 
-	var mapping strings.Builder
-	for _, name := range names {
-		for _, emoji := range groups[name] {
-			// Write the basic emojis.
-			emoji.GoSyntax(&mapping)
+// parseAssetFile parses the Discord asset at the given path. If the path is
+// a directory, all JavaScript files in it are searched for the emoji data.
+func parseAssetFile(path string) (emojidata.Dataset, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return emojidata.Dataset{}, fmt.Errorf("error retrieving absolute filepath for input %q: %w", path, err)
+	}
 
-			// Check if we have toned emojis. Write all of them if we do.
-			for _, emoji := range emoji.Diversities {
-				emoji.GoSyntax(&mapping)
-			}
+	info, err := os.Stat(absPath)
+	if err != nil {
+		return emojidata.Dataset{}, fmt.Errorf("error reading data: %w", err)
+	}
+	if info.IsDir() {
+		dataset, assetPath, err := emojidata.DiscoverAsset(os.DirFS(absPath))
+		if err != nil {
+			return emojidata.Dataset{}, fmt.Errorf("error searching %q: %w", path, err)
 		}
+		fmt.Fprintln(os.Stderr, "Using emoji data from", filepath.Join(path, assetPath))
+		return dataset, nil
 	}
 
-	f, err := os.OpenFile(out, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	asset, err := os.Open(absPath)
 	if err != nil {
-		log.Fatalln("Failed to open output:", err)
+		return emojidata.Dataset{}, fmt.Errorf("error reading data: %w", err)
 	}
-	defer f.Close()
+	defer asset.Close()
 
-	if _, err := fmt.Fprintf(f, goCode, mapping.String()); err != nil {
-		log.Fatalln("Failed to format Go code:", err)
+	dataset, err := emojidata.ParseAsset(asset)
+	if err != nil {
+		return emojidata.Dataset{}, fmt.Errorf("error parsing %q: %w", path, err)
 	}
+	return dataset, nil
 }
@@ -0,0 +1,148 @@
+package discordemojimap
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// CDNBaseURL is the base URL of Discord's CDN that custom emoji images are
+// served from.
+const CDNBaseURL = "https://cdn.discordapp.com/emojis/"
+
+// ImageFormat is the file format a custom emoji image can be requested in.
+type ImageFormat string
+
+// These are the formats that Discord's CDN serves custom emoji in.
+const (
+	PNG  ImageFormat = "png"
+	WebP ImageFormat = "webp"
+	GIF  ImageFormat = "gif"
+)
+
+var (
+	// ErrInvalidCustomEmoji is returned if a string isn't a valid custom
+	// emoji markup such as "<:name:id>" or "<a:name:id>".
+	ErrInvalidCustomEmoji = errors.New("invalid custom emoji")
+	// ErrInvalidImageFormat is returned if the requested format isn't
+	// supported for the given emoji. Static emoji can't be requested as GIF.
+	ErrInvalidImageFormat = errors.New("invalid image format")
+	// ErrInvalidImageSize is returned if the requested size isn't a power of
+	// two between 16 and 4096.
+	ErrInvalidImageSize = errors.New("invalid image size")
+)
+
+// CustomEmoji is a guild specific emoji, as found in message content in the
+// form of "<:name:id>" or "<a:name:id>" for animated emoji.
+type CustomEmoji struct {
+	Name     string `json:"name"`
+	ID       string `json:"id"`
+	Animated bool   `json:"animated,omitempty"`
+}
+
+// ParseCustomEmoji parses the markup of a custom emoji. The whole input has to
+// be the markup, surrounding whitespace isn't trimmed.
+func ParseCustomEmoji(markup string) (CustomEmoji, error) {
+	emoji, length := parseCustomEmoji(markup)
+	if length == 0 || length != len(markup) {
+		return CustomEmoji{}, ErrInvalidCustomEmoji
+	}
+	return emoji, nil
+}
+
+// parseCustomEmoji parses a custom emoji at the start of the input and
+// returns the amount of bytes consumed. If there's no valid custom emoji at
+// the start of the input, 0 is returned.
+func parseCustomEmoji(input string) (CustomEmoji, int) {
+	if len(input) < 5 || input[0] != '<' {
+		return CustomEmoji{}, 0
+	}
+
+	var emoji CustomEmoji
+	index := 1
+	if input[index] == 'a' && input[index+1] == ':' {
+		emoji.Animated = true
+		index++
+	}
+	if input[index] != ':' {
+		return CustomEmoji{}, 0
+	}
+	index++
+
+	nameStart := index
+	for ; index < len(input) && isCustomEmojiNameByte(input[index]); index++ {
+	}
+	// Discord requires custom emoji names to be at least two characters long.
+	if index-nameStart < 2 || index >= len(input) || input[index] != ':' {
+		return CustomEmoji{}, 0
+	}
+	emoji.Name = input[nameStart:index]
+	index++
+
+	idStart := index
+	for ; index < len(input) && input[index] >= '0' && input[index] <= '9'; index++ {
+	}
+	if index == idStart || index >= len(input) || input[index] != '>' {
+		return CustomEmoji{}, 0
+	}
+	emoji.ID = input[idStart:index]
+
+	return emoji, index + 1
+}
+
+func isCustomEmojiNameByte(c byte) bool {
+	return c == '_' ||
+		(c >= 'a' && c <= 'z') ||
+		(c >= 'A' && c <= 'Z') ||
+		(c >= '0' && c <= '9')
+}
+
+// String returns the markup that Discord uses to display the emoji in
+// messages.
+func (emoji CustomEmoji) String() string {
+	if emoji.Animated {
+		return "<a:" + emoji.Name + ":" + emoji.ID + ">"
+	}
+	return "<:" + emoji.Name + ":" + emoji.ID + ">"
+}
+
+// URL returns the CDN URL for the emoji image. A size of 0 omits the size
+// parameter, causing Discord to serve the image in its original size. Any
+// other size has to be a power of two between 16 and 4096.
+//
+// Animated emoji can be requested as GIF, or as animated WebP. PNG always
+// results in a static image.
+func (emoji CustomEmoji) URL(format ImageFormat, size int) (string, error) {
+	switch format {
+	case PNG, WebP:
+	case GIF:
+		if !emoji.Animated {
+			return "", ErrInvalidImageFormat
+		}
+	default:
+		return "", ErrInvalidImageFormat
+	}
+
+	if size != 0 && (size < 16 || size > 4096 || size&(size-1) != 0) {
+		return "", ErrInvalidImageSize
+	}
+
+	var url strings.Builder
+	url.WriteString(CDNBaseURL)
+	url.WriteString(emoji.ID)
+	url.WriteByte('.')
+	url.WriteString(string(format))
+
+	separator := byte('?')
+	if size != 0 {
+		url.WriteString("?size=")
+		url.WriteString(strconv.Itoa(size))
+		separator = '&'
+	}
+	if format == WebP && emoji.Animated {
+		url.WriteByte(separator)
+		url.WriteString("animated=true")
+	}
+
+	return url.String(), nil
+}
@@ -0,0 +1,108 @@
+package discordemojimap
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParseCustomEmoji(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		markup  string
+		want    CustomEmoji
+		wantErr bool
+	}{
+		{name: "empty string", markup: "", wantErr: true},
+		{name: "unicode emoji", markup: "😢", wantErr: true},
+		{name: "emoji code", markup: ":cry:", wantErr: true},
+		{name: "missing id", markup: "<:pepe:>", wantErr: true},
+		{name: "missing name", markup: "<::123>", wantErr: true},
+		{name: "single letter name", markup: "<:p:123>", wantErr: true},
+		{name: "non numeric id", markup: "<:pepe:12a>", wantErr: true},
+		{name: "unterminated", markup: "<:pepe:123", wantErr: true},
+		{name: "trailing text", markup: "<:pepe:123> ", wantErr: true},
+		{name: "invalid animated prefix", markup: "<b:pepe:123>", wantErr: true},
+		{
+			name:   "static",
+			markup: "<:pepe:123>",
+			want:   CustomEmoji{Name: "pepe", ID: "123"},
+		},
+		{
+			name:   "animated",
+			markup: "<a:pepe_dance:456>",
+			want:   CustomEmoji{Name: "pepe_dance", ID: "456", Animated: true},
+		},
+		{
+			name:   "static named a",
+			markup: "<:a_:789>",
+			want:   CustomEmoji{Name: "a_", ID: "789"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseCustomEmoji(tt.markup)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCustomEmoji() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseCustomEmoji() = %+v, want %+v", got, tt.want)
+			}
+			if !tt.wantErr && got.String() != tt.markup {
+				t.Errorf("String() = %q, want %q", got.String(), tt.markup)
+			}
+		})
+	}
+}
+
+func TestCustomEmojiURL(t *testing.T) {
+	t.Parallel()
+
+	static := CustomEmoji{Name: "pepe", ID: "123"}
+	animated := CustomEmoji{Name: "pepe", ID: "123", Animated: true}
+
+	tests := []struct {
+		name    string
+		emoji   CustomEmoji
+		format  ImageFormat
+		size    int
+		want    string
+		wantErr error
+	}{
+		{name: "png without size", emoji: static, format: PNG, want: CDNBaseURL + "123.png"},
+		{name: "png with size", emoji: static, format: PNG, size: 64, want: CDNBaseURL + "123.png?size=64"},
+		{name: "static webp", emoji: static, format: WebP, size: 4096, want: CDNBaseURL + "123.webp?size=4096"},
+		{name: "animated gif", emoji: animated, format: GIF, size: 16, want: CDNBaseURL + "123.gif?size=16"},
+		{name: "animated webp", emoji: animated, format: WebP, size: 32, want: CDNBaseURL + "123.webp?size=32&animated=true"},
+		{name: "animated webp without size", emoji: animated, format: WebP, want: CDNBaseURL + "123.webp?animated=true"},
+		{name: "static gif", emoji: static, format: GIF, wantErr: ErrInvalidImageFormat},
+		{name: "unknown format", emoji: static, format: "jpeg", wantErr: ErrInvalidImageFormat},
+		{name: "size too small", emoji: static, format: PNG, size: 8, wantErr: ErrInvalidImageSize},
+		{name: "size too big", emoji: static, format: PNG, size: 8192, wantErr: ErrInvalidImageSize},
+		{name: "size not power of two", emoji: static, format: PNG, size: 100, wantErr: ErrInvalidImageSize},
+		{name: "negative size", emoji: static, format: PNG, size: -64, wantErr: ErrInvalidImageSize},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.emoji.URL(tt.format, tt.size)
+			if err != tt.wantErr {
+				t.Fatalf("URL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("URL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleCustomEmoji_URL() {
+	emoji, _ := ParseCustomEmoji("<a:pepe_dance:456>")
+	url, _ := emoji.URL(GIF, 64)
+	fmt.Println(url)
+	// Output: https://cdn.discordapp.com/emojis/456.gif?size=64
+}
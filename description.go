@@ -0,0 +1,79 @@
+package discordemojimap
+
+import "strings"
+
+// skinToneNames are the names of the skin tone modifiers, starting with
+// U+1F3FB.
+var skinToneNames = [...]string{
+	"light skin tone",
+	"medium-light skin tone",
+	"medium skin tone",
+	"medium-dark skin tone",
+	"dark skin tone",
+}
+
+// Describe returns a human-readable description of an emoji, which is
+// suitable for screen readers and text-to-speech. This is the CLDR short
+// name, such as "crying face" or "thumbs up: medium skin tone".
+//
+// Emoji without a CLDR name, such as the regional indicators, are described
+// by their primary code, with skin tones appended after a colon. Country
+// flags are described by their ISO 3166 code, for example "flag: DE". If the
+// emoji isn't known, an empty string is returned.
+func Describe(emoji string) string {
+	canonical, exists := emojiIndexLookup(emoji)
+	if !exists {
+		return ""
+	}
+	if name, exists := cldrNames[canonical]; exists {
+		return name
+	}
+
+	var description strings.Builder
+	base := baseEmoji(canonical)
+	if country := CountryForFlag(base); country != "" {
+		description.WriteString("flag: ")
+		description.WriteString(country)
+	} else {
+		description.WriteString(strings.ReplaceAll(codesByEmoji[base][0], "_", " "))
+	}
+
+	if base != canonical {
+		separator := ": "
+		for _, r := range canonical {
+			if r > skinToneBase && r <= skinToneBase+5 {
+				description.WriteString(separator)
+				description.WriteString(skinToneNames[r-skinToneBase-1])
+				separator = ", "
+			}
+		}
+	}
+
+	return description.String()
+}
+
+// ReplaceWithDescriptions replaces all unicode emoji, codes and custom emoji
+// with their description in square brackets. Custom emoji are described by
+// their name. For example:
+//
+//	fmt.Println(ReplaceWithDescriptions("Hi :wave_tone1: <:pepe:123>"))
+//	//Output: Hi [waving hand: light skin tone] [pepe]
+func ReplaceWithDescriptions(input string) string {
+	var buffer strings.Builder
+	buffer.Grow(len(input))
+	for _, token := range Tokenize(input) {
+		switch token.Kind {
+		case EmojiToken, CodeToken:
+			buffer.WriteByte('[')
+			buffer.WriteString(Describe(token.Emoji))
+			buffer.WriteByte(']')
+		case CustomEmojiToken:
+			buffer.WriteByte('[')
+			buffer.WriteString(token.Custom.Name)
+			buffer.WriteByte(']')
+		default:
+			buffer.WriteString(token.Text)
+		}
+	}
+	return buffer.String()
+}
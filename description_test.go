@@ -0,0 +1,75 @@
+package discordemojimap
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDescribe(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		emoji string
+		want  string
+	}{
+		{name: "cldr name", emoji: "😢", want: "crying face"},
+		{name: "skin tone", emoji: "👍🏽", want: "thumbs up: medium skin tone"},
+		{name: "multiple skin tones", emoji: "🫱🏻\u200d🫲🏿", want: "handshake: light skin tone, dark skin tone"},
+		{name: "without variation selector", emoji: "❤", want: "red heart"},
+		{name: "country flag", emoji: "🇩🇪", want: "flag: Germany"},
+		{name: "subdivision flag", emoji: FlagForCountry("GB-SCT"), want: "flag: Scotland"},
+		{name: "unqualified", emoji: "\u26a7", want: "transgender symbol"},
+		{name: "no cldr name", emoji: "\U0001f1ff", want: "regional indicator z"},
+		{name: "unknown", emoji: "a", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Describe(tt.emoji); got != tt.want {
+				t.Errorf("Describe(%q) = %q, want %q", tt.emoji, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEveryEmojiHasDescription(t *testing.T) {
+	t.Parallel()
+
+	for code, emoji := range EmojiMap {
+		if Describe(emoji) == "" {
+			t.Errorf("emoji %q for code %q has no description", emoji, code)
+		}
+	}
+}
+
+func TestReplaceWithDescriptions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "empty", input: "", want: ""},
+		{name: "text", input: "hello", want: "hello"},
+		{name: "unicode emoji", input: "I 😢", want: "I [crying face]"},
+		{name: "code", input: "I :CRY: :invalid:", want: "I [crying face] :invalid:"},
+		{name: "custom emoji", input: "<a:pepe_dance:123>!", want: "[pepe_dance]!"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := ReplaceWithDescriptions(tt.input); got != tt.want {
+				t.Errorf("ReplaceWithDescriptions(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleReplaceWithDescriptions() {
+	fmt.Println(ReplaceWithDescriptions("Hi :wave_tone1: <:pepe:123>"))
+	// Output: Hi [waving hand: light skin tone] [pepe]
+}
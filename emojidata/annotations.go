@@ -0,0 +1,49 @@
+package emojidata
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// cldrAnnotations is the structure of CLDR's annotation files, such as
+// common/annotations/en.xml, which contain lines such as:
+//
+//	<annotation cp="😢">cry | emotion | face | sad | sad tear | tear</annotation>
+//	<annotation cp="😢" type="tts">crying face</annotation>
+type cldrAnnotations struct {
+	Annotations []struct {
+		Codepoints string `xml:"cp,attr"`
+		Type       string `xml:"type,attr"`
+		Text       string `xml:",chardata"`
+	} `xml:"annotations>annotation"`
+}
+
+// ParseAnnotations parses a CLDR annotation file and returns the keywords
+// of every emoji. The names used for text-to-speech are skipped. CLDR
+// omits variation selectors, so the emoji are returned without them.
+func ParseAnnotations(r io.Reader) (map[string][]string, error) {
+	var annotations cldrAnnotations
+	if err := xml.NewDecoder(r).Decode(&annotations); err != nil {
+		return nil, err
+	}
+
+	keywords := make(map[string][]string, len(annotations.Annotations))
+	for _, annotation := range annotations.Annotations {
+		if annotation.Type != "" {
+			continue
+		}
+		if annotation.Codepoints == "" {
+			return nil, fmt.Errorf("annotation %q has no codepoints", annotation.Text)
+		}
+
+		emoji := strings.ReplaceAll(annotation.Codepoints, variationSelector, "")
+		for _, keyword := range strings.Split(annotation.Text, "|") {
+			if keyword = strings.TrimSpace(keyword); keyword != "" {
+				keywords[emoji] = append(keywords[emoji], keyword)
+			}
+		}
+	}
+	return keywords, nil
+}
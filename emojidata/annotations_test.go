@@ -0,0 +1,36 @@
+package emojidata
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAnnotations(t *testing.T) {
+	t.Parallel()
+
+	keywords, err := ParseAnnotations(strings.NewReader(`<?xml version="1.0" encoding="UTF-8" ?>
+<ldml>
+	<annotations>
+		<annotation cp="😢">cry | emotion | face | sad | sad tear | tear</annotation>
+		<annotation cp="😢" type="tts">crying face</annotation>
+		<annotation cp="❤&#xFE0F;">heart</annotation>
+	</annotations>
+</ldml>`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"😢": {"cry", "emotion", "face", "sad", "sad tear", "tear"},
+		"❤": {"heart"},
+	}, keywords)
+}
+
+func TestParseAnnotationsInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseAnnotations(strings.NewReader(`<ldml><annotations><annotation>sad</annotation></annotations></ldml>`))
+	assert.Error(t, err)
+	_, err = ParseAnnotations(strings.NewReader(`<ldml>`))
+	assert.Error(t, err)
+}
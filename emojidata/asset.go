@@ -0,0 +1,154 @@
+// Package emojidata parses Discord's emoji data and writes it as Go code,
+// JSON, CSV or TypeScript. It is used by cmd/extractmap to generate the
+// mapping of discordemojimap, but can be used to build other pipelines on
+// top of the same data.
+package emojidata
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// ErrEmojiJSONNotFound is returned by ParseAsset if the asset doesn't
+// contain Discord's emoji JSON.
+var ErrEmojiJSONNotFound = errors.New("emoji JSON not found")
+
+type EmojiGroups map[string][]Emoji
+
+// GroupNames returns the names of all groups, sorted alphabetically.
+func (eg EmojiGroups) GroupNames() []string {
+	keys := make([]string, 0, len(eg))
+	for key := range eg {
+		keys = append(keys, key)
+	}
+	// Even though maps are unordered, we'd preferably still want a
+	// reproducible output.
+	sort.Strings(keys)
+	return keys
+}
+
+type Emoji struct {
+	Names          []string `json:"names"`
+	Surrogates     string   `json:"surrogates"` // the emoji
+	UnicodeVersion float64  `json:"unicodeVersion"`
+
+	// Optionals
+
+	HasDiversity      bool    `json:"hasDiversity,omitempty"`
+	HasMultiDiversity bool    `json:"hasMultiDiversity,omitempty"`
+	Diversities       []Emoji `json:"diversityChildren,omitempty"`
+}
+
+// GoSyntax writes the representation of the emoji as a single map entry.
+func (e Emoji) GoSyntax(w io.Writer) (n int, err error) {
+	for _, emojiName := range e.Names {
+		// Use %+q so Unicode emojis are formatted as \U or \u.
+		w, err := fmt.Fprintf(w, "\t%q: %+q,\n", emojiName, e.Surrogates)
+		n += w // accumulate bytes written
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+// Dataset is the emoji data of Discord, optionally enriched with data from
+// Unicode and CLDR.
+type Dataset struct {
+	Groups EmojiGroups
+	// Names maps emoji to their CLDR short names, see CrossCheck.
+	Names map[string]string
+	// Keywords maps emoji without variation selectors to their CLDR
+	// keywords, see ParseAnnotations.
+	Keywords map[string][]string
+	// Versions maps emoji to their emoji version, see EmojiTestVersions.
+	// Emoji without a version fall back to Discord's unicodeVersion.
+	Versions map[string]float64
+}
+
+// Entry is a single emoji of a Dataset with all of its codes.
+type Entry struct {
+	Emoji string `json:"emoji"`
+	// Codes are all codes of the emoji, the first one is the primary one.
+	Codes []string `json:"codes"`
+	// Category is the name of the group the emoji is listed in first.
+	Category string `json:"category"`
+	// SkinToneBase is the emoji without skin tones. It is empty if the emoji
+	// has no skin tones.
+	SkinToneBase string `json:"skinToneBase,omitempty"`
+	// Name is the CLDR short name, if the dataset has names.
+	Name string `json:"name,omitempty"`
+	// Keywords are the CLDR keywords, if the dataset has keywords.
+	Keywords []string `json:"keywords,omitempty"`
+	// Version is the emoji version the emoji was introduced in. It is 0 if
+	// the version isn't known.
+	Version float64 `json:"version,omitempty"`
+}
+
+// ParseAsset parses one of Discord's JavaScript assets, which contains the
+// emoji data. The data may be embedded as JSON string literal or as object
+// literal, see DiscoverAsset for details. If the asset doesn't contain the
+// data, a *NotFoundError is returned, which matches ErrEmojiJSONNotFound.
+func ParseAsset(r io.Reader) (Dataset, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Dataset{}, err
+	}
+
+	groups, diagnostics := findEmojiGroups(data)
+	if groups == nil {
+		return Dataset{}, &NotFoundError{Scanned: 1, Diagnostics: diagnostics}
+	}
+	return Dataset{Groups: groups}, nil
+}
+
+// Entries returns all emoji of the dataset. The entries are ordered by group
+// name, with emoji with skin tones following their base emoji. Emoji that
+// are listed more than once are merged into a single entry, without
+// duplicate codes.
+func (d Dataset) Entries() []Entry {
+	var entries []Entry
+	indices := make(map[string]int)
+	addCodes := func(index int, names []string) {
+		for _, name := range names {
+			if !slices.Contains(entries[index].Codes, name) {
+				entries[index].Codes = append(entries[index].Codes, name)
+			}
+		}
+	}
+	add := func(emoji Emoji, category, skinToneBase string) {
+		if index, exists := indices[emoji.Surrogates]; exists {
+			addCodes(index, emoji.Names)
+			return
+		}
+		version, exists := d.Versions[emoji.Surrogates]
+		if !exists {
+			version = emoji.UnicodeVersion
+		}
+		indices[emoji.Surrogates] = len(entries)
+		entries = append(entries, Entry{
+			Emoji:        emoji.Surrogates,
+			Category:     category,
+			SkinToneBase: skinToneBase,
+			Name:         d.Names[emoji.Surrogates],
+			Keywords:     d.Keywords[strings.ReplaceAll(emoji.Surrogates, variationSelector, "")],
+			Version:      version,
+		})
+		addCodes(len(entries)-1, emoji.Names)
+	}
+
+	for _, name := range d.Groups.GroupNames() {
+		for _, emoji := range d.Groups[name] {
+			add(emoji, name, "")
+			for _, toned := range emoji.Diversities {
+				add(toned, name, emoji.Surrogates)
+			}
+		}
+	}
+	return entries
+}
@@ -0,0 +1,48 @@
+package emojidata
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testAsset is a minimal version of Discord's asset containing the emoji
+// JSON.
+const testAsset = `e.exports=JSON.parse('{"people":[` +
+	`{"names":["thumbsup","+1"],"surrogates":"\ud83d\udc4d","unicodeVersion":6,"hasDiversity":true,"diversityChildren":[` +
+	`{"names":["thumbsup_tone1"],"surrogates":"\ud83d\udc4d\ud83c\udffb","unicodeVersion":8}]},` +
+	`{"names":["cry"],"surrogates":"\ud83d\ude22","unicodeVersion":6}],` +
+	`"nature":[{"names":["dog"],"surrogates":"\ud83d\udc36","unicodeVersion":6},` +
+	`{"names":["crying"],"surrogates":"\ud83d\ude22","unicodeVersion":6}]}')`
+
+func TestParseAsset(t *testing.T) {
+	t.Parallel()
+
+	dataset, err := ParseAsset(strings.NewReader(testAsset))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"nature", "people"}, dataset.Groups.GroupNames())
+	assert.Equal(t, "👍🏻", dataset.Groups["people"][0].Diversities[0].Surrogates)
+}
+
+func TestParseAssetWithoutJSON(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseAsset(strings.NewReader("console.log('hello')"))
+	assert.ErrorIs(t, err, ErrEmojiJSONNotFound)
+}
+
+func TestDatasetEntries(t *testing.T) {
+	t.Parallel()
+
+	dataset, err := ParseAsset(strings.NewReader(testAsset))
+	require.NoError(t, err)
+	dataset.Versions = map[string]float64{"👍🏻": 1.0}
+	assert.Equal(t, []Entry{
+		{Emoji: "🐶", Codes: []string{"dog"}, Category: "nature", Version: 6},
+		{Emoji: "😢", Codes: []string{"crying", "cry"}, Category: "nature", Version: 6},
+		{Emoji: "👍", Codes: []string{"thumbsup", "+1"}, Category: "people", Version: 6},
+		{Emoji: "👍🏻", Codes: []string{"thumbsup_tone1"}, Category: "people", SkinToneBase: "👍", Version: 1.0},
+	}, dataset.Entries())
+}
@@ -0,0 +1,135 @@
+package emojidata
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// CodeChange is a code that was added or removed.
+type CodeChange struct {
+	Code  string `json:"code"`
+	Emoji string `json:"emoji"`
+}
+
+// Rename is an emoji whose code was replaced with another code.
+type Rename struct {
+	Emoji string `json:"emoji"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// Repoint is a code that points at another emoji.
+type Repoint struct {
+	Code string `json:"code"`
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// Diff contains the changes between two mappings of codes to emoji. All
+// changes are sorted by code.
+type Diff struct {
+	Added     []CodeChange `json:"added"`
+	Removed   []CodeChange `json:"removed"`
+	Renamed   []Rename     `json:"renamed"`
+	Repointed []Repoint    `json:"repointed"`
+}
+
+// Mapping returns the codes of all emoji of the dataset, same as EmojiMap
+// of the generated code.
+func (d Dataset) Mapping() map[string]string {
+	mapping := make(map[string]string)
+	for _, entry := range d.Entries() {
+		for _, code := range entry.Codes {
+			mapping[code] = entry.Emoji
+		}
+	}
+	return mapping
+}
+
+// DiffMappings compares two mappings of codes to emoji. If an emoji lost a
+// code and gained another one, the change is reported as a rename rather
+// than as an addition and a removal. If an emoji was renamed more than once,
+// the codes are paired in alphabetical order.
+func DiffMappings(previous, current map[string]string) Diff {
+	diff := Diff{
+		Added:     []CodeChange{},
+		Removed:   []CodeChange{},
+		Renamed:   []Rename{},
+		Repointed: []Repoint{},
+	}
+
+	removedByEmoji := make(map[string][]string)
+	for code, emoji := range previous {
+		currentEmoji, exists := current[code]
+		switch {
+		case !exists:
+			removedByEmoji[emoji] = append(removedByEmoji[emoji], code)
+		case currentEmoji != emoji:
+			diff.Repointed = append(diff.Repointed, Repoint{Code: code, From: emoji, To: currentEmoji})
+		}
+	}
+	addedByEmoji := make(map[string][]string)
+	for code, emoji := range current {
+		if _, exists := previous[code]; !exists {
+			addedByEmoji[emoji] = append(addedByEmoji[emoji], code)
+		}
+	}
+
+	for emoji, removed := range removedByEmoji {
+		added := addedByEmoji[emoji]
+		sort.Strings(removed)
+		sort.Strings(added)
+		renamed := min(len(removed), len(added))
+		for index := 0; index < renamed; index++ {
+			diff.Renamed = append(diff.Renamed, Rename{Emoji: emoji, From: removed[index], To: added[index]})
+		}
+		for _, code := range removed[renamed:] {
+			diff.Removed = append(diff.Removed, CodeChange{Code: code, Emoji: emoji})
+		}
+		addedByEmoji[emoji] = added[renamed:]
+	}
+	for emoji, added := range addedByEmoji {
+		for _, code := range added {
+			diff.Added = append(diff.Added, CodeChange{Code: code, Emoji: emoji})
+		}
+	}
+
+	sort.Slice(diff.Added, func(a, b int) bool { return diff.Added[a].Code < diff.Added[b].Code })
+	sort.Slice(diff.Removed, func(a, b int) bool { return diff.Removed[a].Code < diff.Removed[b].Code })
+	sort.Slice(diff.Renamed, func(a, b int) bool { return diff.Renamed[a].From < diff.Renamed[b].From })
+	sort.Slice(diff.Repointed, func(a, b int) bool { return diff.Repointed[a].Code < diff.Repointed[b].Code })
+	return diff
+}
+
+// IsEmpty returns true if the mappings are the same.
+func (d Diff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Renamed) == 0 && len(d.Repointed) == 0
+}
+
+// WriteText writes the diff in a human-readable form, one change per line.
+// Removals, renames and repointed codes are written first, since they can
+// break stored messages relying on the codes.
+func (d Diff) WriteText(w io.Writer) error {
+	for _, change := range d.Removed {
+		if _, err := fmt.Fprintf(w, "removed: :%s: %s\n", change.Code, change.Emoji); err != nil {
+			return err
+		}
+	}
+	for _, rename := range d.Renamed {
+		if _, err := fmt.Fprintf(w, "renamed: :%s: -> :%s: %s\n", rename.From, rename.To, rename.Emoji); err != nil {
+			return err
+		}
+	}
+	for _, repoint := range d.Repointed {
+		if _, err := fmt.Fprintf(w, "repointed: :%s: %s -> %s\n", repoint.Code, repoint.From, repoint.To); err != nil {
+			return err
+		}
+	}
+	for _, change := range d.Added {
+		if _, err := fmt.Fprintf(w, "added: :%s: %s\n", change.Code, change.Emoji); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package emojidata
+
+import (
+	"bytes"
+	"go/format"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffMappings(t *testing.T) {
+	t.Parallel()
+
+	previous := map[string]string{
+		"cry":      "😢",
+		"sob":      "😭",
+		"dog":      "🐶",
+		"old_cat":  "🐱",
+		"thumbsup": "👍",
+		"removed":  "🦖",
+	}
+	current := map[string]string{
+		"cry":      "😭",
+		"sob":      "😭",
+		"dog":      "🐶",
+		"cat":      "🐱",
+		"thumbsup": "👍",
+		"+1":       "👍",
+	}
+
+	diff := DiffMappings(previous, current)
+	assert.Equal(t, Diff{
+		Added:     []CodeChange{{Code: "+1", Emoji: "👍"}},
+		Removed:   []CodeChange{{Code: "removed", Emoji: "🦖"}},
+		Renamed:   []Rename{{Emoji: "🐱", From: "old_cat", To: "cat"}},
+		Repointed: []Repoint{{Code: "cry", From: "😢", To: "😭"}},
+	}, diff)
+	assert.False(t, diff.IsEmpty())
+
+	var text strings.Builder
+	require.NoError(t, diff.WriteText(&text))
+	assert.Equal(t, `removed: :removed: 🦖
+renamed: :old_cat: -> :cat: 🐱
+repointed: :cry: 😢 -> 😭
+added: :+1: 👍
+`, text.String())
+}
+
+func TestDiffMappingsEmpty(t *testing.T) {
+	t.Parallel()
+
+	mapping := map[string]string{"cry": "😢"}
+	assert.True(t, DiffMappings(mapping, mapping).IsEmpty())
+}
+
+func TestParseGoMappingRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	dataset, err := ParseAsset(strings.NewReader(testAsset))
+	require.NoError(t, err)
+
+	var code strings.Builder
+	require.NoError(t, WriteGo(&code, dataset, GoOptions{}))
+	mapping, err := ParseGoMapping(strings.NewReader(code.String()))
+	require.NoError(t, err)
+	assert.Equal(t, dataset.Mapping(), mapping)
+	assert.Len(t, mapping, 6)
+}
+
+func TestParseGoMappingFormatted(t *testing.T) {
+	t.Parallel()
+
+	dataset, err := ParseAsset(strings.NewReader(testAsset))
+	require.NoError(t, err)
+
+	var code strings.Builder
+	require.NoError(t, WriteGo(&code, dataset, GoOptions{}))
+	formatted, err := format.Source([]byte(code.String()))
+	require.NoError(t, err)
+	require.NotEqual(t, code.String(), string(formatted))
+
+	mapping, err := ParseGoMapping(bytes.NewReader(formatted))
+	require.NoError(t, err)
+	assert.Equal(t, dataset.Mapping(), mapping)
+}
+
+func TestParseGoMappingInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseGoMapping(strings.NewReader("package discordemojimap\n"))
+	assert.ErrorIs(t, err, ErrEmojiMapNotFound)
+	_, err = ParseGoMapping(strings.NewReader("package discordemojimap\nvar EmojiMap = map[string]string {\n\t\"cry\" \"x\",\n}\n"))
+	assert.Error(t, err)
+	_, err = ParseGoMapping(strings.NewReader("package discordemojimap\nvar EmojiMap = map[string]string {\n\t\"cry\": x,\n}\n"))
+	assert.Error(t, err)
+	_, err = ParseGoMapping(strings.NewReader("package discordemojimap\nvar EmojiMap = newMap()\n"))
+	assert.Error(t, err)
+}
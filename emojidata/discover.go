@@ -0,0 +1,205 @@
+package emojidata
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// emojiDataRegex matches the start of the emoji data in an asset. The data
+// is an object whose first key is one of the group names, which may be
+// quoted, quoted with escaped quotes, or unquoted. For example:
+//
+//	JSON.parse('{"people":[...
+//	JSON.parse("{\"people\":[...
+//	e.exports={people:[...
+var emojiDataRegex = regexp.MustCompile(`\{\s*(?:\\?["'])?(?:people|activity|flags|food|nature|objects|symbols|travel)(?:\\?["'])?\s*:\s*\[`)
+
+// Diagnostic describes why a part of an asset that looked like emoji data
+// couldn't be used.
+type Diagnostic struct {
+	// File is the path of the asset, it's empty for ParseAsset.
+	File string
+	// Offset is the byte offset of the candidate in the asset. It is -1 if
+	// the asset couldn't be downloaded.
+	Offset  int
+	Message string
+}
+
+func (d Diagnostic) String() string {
+	if d.Offset < 0 {
+		return fmt.Sprintf("%s: %s", d.File, d.Message)
+	}
+	if d.File == "" {
+		return fmt.Sprintf("offset %d: %s", d.Offset, d.Message)
+	}
+	return fmt.Sprintf("%s: offset %d: %s", d.File, d.Offset, d.Message)
+}
+
+// NotFoundError is returned if no emoji data could be found. It contains a
+// diagnostic for every candidate that was rejected, which helps adjusting
+// the generator if Discord changes the structure of its assets.
+type NotFoundError struct {
+	// Scanned is the amount of scanned assets.
+	Scanned     int
+	Diagnostics []Diagnostic
+}
+
+func (e *NotFoundError) Error() string {
+	var message strings.Builder
+	message.WriteString(ErrEmojiJSONNotFound.Error())
+	if e.Scanned > 1 {
+		fmt.Fprintf(&message, " in %d assets", e.Scanned)
+	}
+	if len(e.Diagnostics) == 0 {
+		message.WriteString(": no candidates found, expected an object starting with one of the group names, such as {\"people\":[")
+	}
+	for _, diagnostic := range e.Diagnostics {
+		message.WriteString("\n\t")
+		message.WriteString(diagnostic.String())
+	}
+	return message.String()
+}
+
+// Unwrap allows checking for ErrEmojiJSONNotFound with errors.Is.
+func (e *NotFoundError) Unwrap() error {
+	return ErrEmojiJSONNotFound
+}
+
+// findEmojiGroups locates the emoji data in the asset. The data can be
+// embedded as string literal in any kind of quotes, usually as argument of
+// JSON.parse, or as plain object literal, as done by webpack. If there is
+// more than one candidate, the one with the most emoji is used.
+func findEmojiGroups(data []byte) (EmojiGroups, []Diagnostic) {
+	var best EmojiGroups
+	bestCount := 0
+	var diagnostics []Diagnostic
+	searchOffset := 0
+	for searchOffset < len(data) {
+		match := emojiDataRegex.FindIndex(data[searchOffset:])
+		if match == nil {
+			break
+		}
+		start := searchOffset + match[0]
+
+		groups, end, err := parseCandidate(data, start)
+		if err != nil {
+			diagnostics = append(diagnostics, Diagnostic{Offset: start, Message: err.Error()})
+			searchOffset = start + 1
+			continue
+		}
+		searchOffset = end
+
+		if count := countEmoji(groups); count > bestCount {
+			best, bestCount = groups, count
+		} else if count == 0 {
+			diagnostics = append(diagnostics, Diagnostic{Offset: start, Message: "parsed, but contains no emoji with names and surrogates; the structure may have changed"})
+		}
+	}
+
+	if best == nil {
+		return nil, diagnostics
+	}
+	return best, nil
+}
+
+// parseCandidate parses the emoji data starting at the opening brace. If
+// the brace is preceded by a quote, the data is parsed as string literal
+// containing JSON, otherwise as object literal.
+func parseCandidate(data []byte, start int) (EmojiGroups, int, error) {
+	var value any
+	var end int
+	if start > 0 && strings.IndexByte("'\"`", data[start-1]) != -1 {
+		literal, literalEnd, err := parseJSString(data, start-1)
+		if err != nil {
+			return nil, start, fmt.Errorf("invalid string literal: %w", err)
+		}
+		if err := json.Unmarshal([]byte(literal), &value); err != nil {
+			return nil, start, fmt.Errorf("string literal doesn't contain valid JSON: %w", err)
+		}
+		end = literalEnd
+	} else {
+		var err error
+		if value, end, err = parseJSValue(data, start); err != nil {
+			return nil, start, fmt.Errorf("invalid object literal: %w", err)
+		}
+	}
+
+	// Round trip through JSON, so the same decoding rules apply to all
+	// kinds of literals.
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, start, err
+	}
+	var groups EmojiGroups
+	if err := json.Unmarshal(encoded, &groups); err != nil {
+		return nil, start, fmt.Errorf("unexpected structure: %w", err)
+	}
+	return groups, end, nil
+}
+
+// countEmoji returns the amount of emoji with names and surrogates.
+func countEmoji(groups EmojiGroups) int {
+	var count int
+	for _, emojis := range groups {
+		for _, emoji := range emojis {
+			if len(emoji.Names) > 0 && emoji.Surrogates != "" {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// DiscoverAsset searches all JavaScript files in the file system for the
+// emoji data and parses it. This allows passing a directory of Discord's
+// bundles without knowing which one contains the data. If more than one
+// asset contains emoji data, the one with the most emoji is used.
+//
+// The path of the used asset is returned. If no asset contains emoji data,
+// a *NotFoundError with diagnostics for all assets is returned.
+func DiscoverAsset(fsys fs.FS) (Dataset, string, error) {
+	var best Dataset
+	var bestPath string
+	bestCount := 0
+	notFound := &NotFoundError{}
+	err := fs.WalkDir(fsys, ".", func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || path.Ext(filePath) != ".js" {
+			return nil
+		}
+
+		data, err := fs.ReadFile(fsys, filePath)
+		if err != nil {
+			return err
+		}
+		notFound.Scanned++
+
+		groups, diagnostics := findEmojiGroups(data)
+		for _, diagnostic := range diagnostics {
+			diagnostic.File = filePath
+			notFound.Diagnostics = append(notFound.Diagnostics, diagnostic)
+		}
+		if count := countEmoji(groups); count > bestCount {
+			best, bestPath, bestCount = Dataset{Groups: groups}, filePath, count
+		}
+		return nil
+	})
+	if err != nil {
+		return Dataset{}, "", err
+	}
+
+	if bestPath == "" {
+		if notFound.Scanned == 0 {
+			return Dataset{}, "", errors.New("no JavaScript assets found")
+		}
+		return Dataset{}, "", notFound
+	}
+	return best, bestPath, nil
+}
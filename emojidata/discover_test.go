@@ -0,0 +1,102 @@
+package emojidata
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAssetFormats(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		asset string
+	}{
+		{name: "single quotes", asset: testAsset},
+		{
+			name:  "double quotes with escaped JSON",
+			asset: `e.exports=JSON.parse("{\"people\":[{\"names\":[\"cry\"],\"surrogates\":\"\\ud83d\\ude22\",\"unicodeVersion\":6}]}")`,
+		},
+		{
+			name:  "backticks",
+			asset: "e.exports=JSON.parse(`{\"people\":[{\"names\":[\"cry\"],\"surrogates\":\"😢\",\"unicodeVersion\":6}]}`)",
+		},
+		{
+			name:  "webpack module object",
+			asset: `12345:function(e){e.exports={people:[{names:["cry"],surrogates:"😢",unicodeVersion:6,hasDiversity:!1,},],}}`,
+		},
+		{
+			name:  "quoted keys in object literal",
+			asset: `var e={'people':[{"names":['cry'],"surrogates":'\u{1F622}',unicodeVersion:6.0}]};`,
+		},
+		{
+			name: "most emoji win",
+			asset: `var a={people:[{names:["cry"],surrogates:"😢"}]};` +
+				`var b={people:[{names:["cry"],surrogates:"😢"},{names:["dog"],surrogates:"🐶"}]};`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dataset, err := ParseAsset(strings.NewReader(tt.asset))
+			require.NoError(t, err)
+			assert.Equal(t, "😢", dataset.Mapping()["cry"])
+		})
+	}
+}
+
+func TestParseAssetDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseAsset(strings.NewReader(`a={people:[{names:["cry"],surrogates:x}]};b='{"people":[{"name":"cry"}]}'`))
+	require.ErrorIs(t, err, ErrEmojiJSONNotFound)
+
+	var notFound *NotFoundError
+	require.ErrorAs(t, err, &notFound)
+	require.Len(t, notFound.Diagnostics, 2)
+	assert.Equal(t, 2, notFound.Diagnostics[0].Offset)
+	assert.Contains(t, notFound.Diagnostics[0].Message, `unsupported identifier "x"`)
+	assert.Contains(t, notFound.Diagnostics[1].Message, "structure may have changed")
+
+	_, err = ParseAsset(strings.NewReader("console.log('hello')"))
+	require.ErrorAs(t, err, &notFound)
+	assert.Contains(t, err.Error(), "no candidates found")
+}
+
+func TestDiscoverAsset(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{
+		"main.js":             {Data: []byte("console.log('hello')")},
+		"chunks/emoji.js":     {Data: []byte(testAsset)},
+		"chunks/emoji.js.map": {Data: []byte(testAsset)},
+		"chunks/broken.js":    {Data: []byte(`{"people":[`)},
+	}
+	dataset, path, err := DiscoverAsset(fsys)
+	require.NoError(t, err)
+	assert.Equal(t, "chunks/emoji.js", path)
+	assert.Equal(t, "👍", dataset.Mapping()["+1"])
+}
+
+func TestDiscoverAssetNotFound(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := DiscoverAsset(fstest.MapFS{
+		"main.js":   {Data: []byte("console.log('hello')")},
+		"broken.js": {Data: []byte(`x='{"people":[`)},
+	})
+	var notFound *NotFoundError
+	require.ErrorAs(t, err, &notFound)
+	assert.Equal(t, 2, notFound.Scanned)
+	require.Len(t, notFound.Diagnostics, 1)
+	assert.Equal(t, "broken.js", notFound.Diagnostics[0].File)
+	assert.Contains(t, err.Error(), "emoji JSON not found in 2 assets\n\tbroken.js: offset 3: invalid string literal")
+
+	_, _, err = DiscoverAsset(fstest.MapFS{"style.css": {Data: []byte("a{}")}})
+	assert.EqualError(t, err, "no JavaScript assets found")
+}
@@ -0,0 +1,184 @@
+package emojidata
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// These are the statuses used in Unicode's emoji-test.txt.
+const (
+	StatusComponent          = "component"
+	StatusFullyQualified     = "fully-qualified"
+	StatusMinimallyQualified = "minimally-qualified"
+	StatusUnqualified        = "unqualified"
+)
+
+// EmojiTestEntry is a single line of Unicode's emoji-test.txt, for example:
+//
+//	1F622 ; fully-qualified # 😢 E0.6 crying face
+type EmojiTestEntry struct {
+	Emoji  string
+	Status string
+	// Version is the emoji version the emoji was introduced in, such as 0.6.
+	Version float64
+	// Name is the CLDR short name, such as "crying face".
+	Name string
+}
+
+// ParseEmojiTest parses Unicode's emoji-test.txt, which can be found at
+// https://unicode.org/Public/emoji/latest/emoji-test.txt. The entries are
+// returned in the order of the file.
+func ParseEmojiTest(r io.Reader) ([]EmojiTestEntry, error) {
+	var entries []EmojiTestEntry
+	scanner := bufio.NewScanner(r)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		codepoints, rest, found := strings.Cut(line, ";")
+		if !found {
+			return nil, fmt.Errorf("line %d: missing status", lineNumber)
+		}
+		status, comment, found := strings.Cut(rest, "#")
+		if !found {
+			return nil, fmt.Errorf("line %d: missing name", lineNumber)
+		}
+
+		var emoji strings.Builder
+		for _, field := range strings.Fields(codepoints) {
+			codepoint, err := strconv.ParseUint(field, 16, 32)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid codepoint %q: %w", lineNumber, field, err)
+			}
+			emoji.WriteRune(rune(codepoint))
+		}
+
+		// The comment consists of the emoji, the emoji version, such as
+		// "E0.6", and the name.
+		fields := strings.SplitN(strings.TrimSpace(comment), " ", 3)
+		if len(fields) < 3 || !strings.HasPrefix(fields[1], "E") {
+			return nil, fmt.Errorf("line %d: malformed comment %q", lineNumber, comment)
+		}
+		version, err := strconv.ParseFloat(fields[1][1:], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid emoji version %q: %w", lineNumber, fields[1], err)
+		}
+
+		entries = append(entries, EmojiTestEntry{
+			Emoji:   emoji.String(),
+			Status:  strings.TrimSpace(status),
+			Version: version,
+			Name:    fields[2],
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// EmojiTestVersions returns the emoji versions of all entries, which can be
+// used as the Versions of a dataset. Other than Discord's unicodeVersion,
+// these are the versions of the whole sequences, so a ZWJ sequence has the
+// version it was added in, not the version of its newest part.
+func EmojiTestVersions(entries []EmojiTestEntry) map[string]float64 {
+	versions := make(map[string]float64, len(entries))
+	for _, entry := range entries {
+		versions[entry.Emoji] = entry.Version
+	}
+	return versions
+}
+
+// MismatchKind is the kind of difference between Discord's emoji and the
+// emoji of emoji-test.txt.
+type MismatchKind string
+
+// These are the differences reported by CrossCheck.
+const (
+	// MissingFromDiscord is a fully qualified emoji that Discord doesn't
+	// know.
+	MissingFromDiscord MismatchKind = "missing from Discord"
+	// NotRGI is an emoji known to Discord that isn't listed in
+	// emoji-test.txt, meaning it isn't part of the RGI set.
+	NotRGI MismatchKind = "not RGI"
+	// NotFullyQualified is an emoji known to Discord that isn't fully
+	// qualified. The status of the Mismatch contains its qualification.
+	NotFullyQualified MismatchKind = "not fully qualified"
+)
+
+// Mismatch is a single difference found by CrossCheck.
+type Mismatch struct {
+	Kind  MismatchKind
+	Emoji string
+	// Code is the primary code of the emoji. It is empty for emoji missing
+	// from Discord.
+	Code string
+	// Status and Name are empty for emoji that aren't RGI.
+	Status string
+	Name   string
+}
+
+// String returns a single line describing the mismatch.
+func (m Mismatch) String() string {
+	switch m.Kind {
+	case MissingFromDiscord:
+		return fmt.Sprintf("%s: %+q %s", m.Kind, m.Emoji, m.Name)
+	case NotRGI:
+		return fmt.Sprintf("%s: :%s: %+q", m.Kind, m.Code, m.Emoji)
+	default:
+		return fmt.Sprintf("%s: :%s: %+q %s", m.Status, m.Code, m.Emoji, m.Name)
+	}
+}
+
+// CrossCheck compares the emoji of the dataset with the emoji of
+// emoji-test.txt. It returns the CLDR names of the dataset's emoji,
+// including emoji that aren't fully qualified, which can be used as the
+// Names of the dataset.
+func CrossCheck(dataset Dataset, entries []EmojiTestEntry) (map[string]string, []Mismatch) {
+	byEmoji := make(map[string]EmojiTestEntry, len(entries))
+	for _, entry := range entries {
+		byEmoji[entry.Emoji] = entry
+	}
+
+	datasetEntries := dataset.Entries()
+	known := make(map[string]bool, len(datasetEntries))
+	names := make(map[string]string, len(datasetEntries))
+	var mismatches []Mismatch
+	for _, datasetEntry := range datasetEntries {
+		emoji := datasetEntry.Emoji
+		known[emoji] = true
+		entry, exists := byEmoji[emoji]
+		switch {
+		case !exists:
+			mismatches = append(mismatches, Mismatch{Kind: NotRGI, Emoji: emoji, Code: datasetEntry.Codes[0]})
+			continue
+		case entry.Status != StatusFullyQualified:
+			mismatches = append(mismatches, Mismatch{
+				Kind:   NotFullyQualified,
+				Emoji:  emoji,
+				Code:   datasetEntry.Codes[0],
+				Status: entry.Status,
+				Name:   entry.Name,
+			})
+		}
+		names[emoji] = entry.Name
+	}
+
+	for _, entry := range entries {
+		if entry.Status == StatusFullyQualified && !known[entry.Emoji] {
+			mismatches = append(mismatches, Mismatch{
+				Kind:   MissingFromDiscord,
+				Emoji:  entry.Emoji,
+				Status: entry.Status,
+				Name:   entry.Name,
+			})
+		}
+	}
+
+	return names, mismatches
+}
@@ -0,0 +1,78 @@
+package emojidata
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testEmojiTest = `# emoji-test.txt
+# group: Smileys & Emotion
+
+1F622                                      ; fully-qualified     # 😢 E0.6 crying face
+1F62D                                      ; fully-qualified     # 😭 E0.6 loudly crying face
+1F44D                                      ; fully-qualified     # 👍 E0.6 thumbs up
+1F44D 1F3FB                                ; fully-qualified     # 👍🏻 E1.0 thumbs up: light skin tone
+1F436                                      ; unqualified         # 🐶 E0.6 dog face
+`
+
+func TestParseEmojiTest(t *testing.T) {
+	t.Parallel()
+
+	entries, err := ParseEmojiTest(strings.NewReader(testEmojiTest))
+	require.NoError(t, err)
+	require.Len(t, entries, 5)
+	assert.Equal(t, EmojiTestEntry{Emoji: "👍🏻", Status: StatusFullyQualified, Version: 1.0, Name: "thumbs up: light skin tone"}, entries[3])
+}
+
+func TestEmojiTestVersions(t *testing.T) {
+	t.Parallel()
+
+	entries, err := ParseEmojiTest(strings.NewReader(testEmojiTest + `1F636 200D 1F32B FE0F ; fully-qualified # 😶‍🌫️ E13.1 face in clouds
+`))
+	require.NoError(t, err)
+	versions := EmojiTestVersions(entries)
+	assert.Equal(t, 0.6, versions["👍"])
+	assert.Equal(t, 1.0, versions["👍🏻"])
+	assert.Equal(t, 13.1, versions["😶\u200d🌫️"])
+}
+
+func TestParseEmojiTestInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{
+		"1F622 fully-qualified # 😢 E0.6 crying face",
+		"1F622 ; fully-qualified",
+		"XYZ ; fully-qualified # 😢 E0.6 crying face",
+		"1F622 ; fully-qualified # 😢",
+		"1F622 ; fully-qualified # 😢 Ex crying face",
+	} {
+		_, err := ParseEmojiTest(strings.NewReader(input))
+		assert.Error(t, err, input)
+	}
+}
+
+func TestCrossCheck(t *testing.T) {
+	t.Parallel()
+
+	dataset, err := ParseAsset(strings.NewReader(testAsset))
+	require.NoError(t, err)
+	entries, err := ParseEmojiTest(strings.NewReader(testEmojiTest))
+	require.NoError(t, err)
+
+	names, mismatches := CrossCheck(dataset, entries)
+	assert.Equal(t, map[string]string{
+		"🐶":  "dog face",
+		"😢":  "crying face",
+		"👍":  "thumbs up",
+		"👍🏻": "thumbs up: light skin tone",
+	}, names)
+	assert.Equal(t, []Mismatch{
+		{Kind: NotFullyQualified, Emoji: "🐶", Code: "dog", Status: StatusUnqualified, Name: "dog face"},
+		{Kind: MissingFromDiscord, Emoji: "😭", Status: StatusFullyQualified, Name: "loudly crying face"},
+	}, mismatches)
+	assert.Equal(t, `unqualified: :dog: "\U0001f436" dog face`, mismatches[0].String())
+	assert.Equal(t, `missing from Discord: "\U0001f62d" loudly crying face`, mismatches[1].String())
+}
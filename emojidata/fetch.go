@@ -0,0 +1,159 @@
+package emojidata
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+)
+
+// DefaultBaseURL is the URL Discord's web app is served from.
+const DefaultBaseURL = "https://discord.com"
+
+// DefaultMaxAssets is the default amount of assets Fetcher downloads at
+// most.
+const DefaultMaxAssets = 100
+
+var (
+	// htmlAssetRegex matches the scripts that the app HTML loads or
+	// preloads.
+	htmlAssetRegex = regexp.MustCompile(`(?:src|href)="([^"]+\.js)"`)
+	// scriptAssetRegex matches references to other assets in scripts, such
+	// as lazily loaded chunks.
+	scriptAssetRegex = regexp.MustCompile(`["'](/assets/[\w.-]+\.js)["']`)
+)
+
+// Fetcher downloads Discord's web app and searches its assets for the emoji
+// data. The zero value fetches from DefaultBaseURL.
+type Fetcher struct {
+	// BaseURL is the URL of Discord's web app, the app HTML is loaded from
+	// BaseURL + "/app". It defaults to DefaultBaseURL.
+	BaseURL string
+	// Client is used for all requests. It defaults to http.DefaultClient.
+	Client *http.Client
+	// MaxAssets is the maximum amount of assets that are downloaded. It
+	// defaults to DefaultMaxAssets.
+	MaxAssets int
+}
+
+// FetchResult is the asset containing the emoji data.
+type FetchResult struct {
+	// URL is the URL of the asset.
+	URL string
+	// Asset is the content of the asset, so it can be stored for later use.
+	Asset   []byte
+	Dataset Dataset
+}
+
+// Fetch downloads the app HTML and searches the scripts it loads for the
+// emoji data. Scripts referenced by these scripts, such as lazily loaded
+// chunks, are searched as well. The first asset containing emoji data is
+// returned.
+//
+// Only a failure to download the app HTML is returned as error. Assets that
+// can't be downloaded are skipped, since a single missing chunk shouldn't
+// stop the search. If no asset contains emoji data, a *NotFoundError with
+// diagnostics for all assets, including the failed downloads, is returned.
+func (f Fetcher) Fetch(ctx context.Context) (FetchResult, error) {
+	baseURL := f.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	maxAssets := f.MaxAssets
+	if maxAssets <= 0 {
+		maxAssets = DefaultMaxAssets
+	}
+
+	appURL, err := url.Parse(baseURL)
+	if err != nil {
+		return FetchResult{}, fmt.Errorf("invalid base URL: %w", err)
+	}
+	appURL = appURL.JoinPath("app")
+
+	html, err := f.get(ctx, appURL.String())
+	if err != nil {
+		return FetchResult{}, err
+	}
+
+	var queue []string
+	queued := make(map[string]bool)
+	enqueue := func(base *url.URL, reference string) {
+		resolved, err := base.Parse(reference)
+		if err != nil || queued[resolved.String()] {
+			return
+		}
+		queued[resolved.String()] = true
+		queue = append(queue, resolved.String())
+	}
+	for _, match := range htmlAssetRegex.FindAllSubmatch(html, -1) {
+		enqueue(appURL, string(match[1]))
+	}
+	if len(queue) == 0 {
+		return FetchResult{}, fmt.Errorf("no scripts found in %s", appURL)
+	}
+
+	notFound := &NotFoundError{}
+	for requested := 0; len(queue) > 0 && requested < maxAssets; requested++ {
+		assetURL := queue[0]
+		queue = queue[1:]
+
+		asset, err := f.get(ctx, assetURL)
+		if err != nil {
+			// There's no point in trying the remaining assets once the
+			// context is done.
+			if ctx.Err() != nil {
+				return FetchResult{}, ctx.Err()
+			}
+			notFound.Diagnostics = append(notFound.Diagnostics, Diagnostic{File: assetURL, Offset: -1, Message: err.Error()})
+			continue
+		}
+		notFound.Scanned++
+
+		groups, diagnostics := findEmojiGroups(asset)
+		if groups != nil {
+			return FetchResult{URL: assetURL, Asset: asset, Dataset: Dataset{Groups: groups}}, nil
+		}
+		for _, diagnostic := range diagnostics {
+			diagnostic.File = assetURL
+			notFound.Diagnostics = append(notFound.Diagnostics, diagnostic)
+		}
+
+		parsedAssetURL, err := url.Parse(assetURL)
+		if err != nil {
+			continue
+		}
+		for _, match := range scriptAssetRegex.FindAllSubmatch(asset, -1) {
+			enqueue(parsedAssetURL, string(match[1]))
+		}
+	}
+
+	return FetchResult{}, notFound
+}
+
+func (f Fetcher) get(ctx context.Context, rawURL string) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client := f.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: unexpected status %s", rawURL, response.Status)
+	}
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("GET %s: %w", rawURL, err)
+	}
+	return body, nil
+}
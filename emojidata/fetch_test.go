@@ -0,0 +1,105 @@
+package emojidata
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestServer serves the given paths and counts the requests.
+func newTestServer(t *testing.T, files map[string]string) (*httptest.Server, map[string]int) {
+	t.Helper()
+
+	requests := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		content, exists := files[r.URL.Path]
+		if !exists {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(content))
+	}))
+	t.Cleanup(server.Close)
+	return server, requests
+}
+
+func TestFetcherFetch(t *testing.T) {
+	t.Parallel()
+
+	server, requests := newTestServer(t, map[string]string{
+		"/app": `<html><head>
+<link rel="prefetch" as="script" href="/assets/prefetch.js">
+</head><body><script src="/assets/main.js"></script></body></html>`,
+		"/assets/prefetch.js": "console.log('prefetch')",
+		"/assets/main.js":     `e.l=function(){return "/assets/chunk.js"};e.m="/assets/main.js"`,
+		"/assets/chunk.js":    testAsset,
+	})
+
+	result, err := Fetcher{BaseURL: server.URL}.Fetch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, server.URL+"/assets/chunk.js", result.URL)
+	assert.Equal(t, testAsset, string(result.Asset))
+	assert.Equal(t, "😢", result.Dataset.Mapping()["cry"])
+	assert.Equal(t, map[string]int{"/app": 1, "/assets/prefetch.js": 1, "/assets/main.js": 1, "/assets/chunk.js": 1}, requests)
+}
+
+func TestFetcherFetchNotFound(t *testing.T) {
+	t.Parallel()
+
+	server, requests := newTestServer(t, map[string]string{
+		"/app":         `<script src="/assets/a.js"></script>`,
+		"/assets/a.js": `x='{"people":[';y="/assets/b.js"`,
+		"/assets/b.js": `y="/assets/c.js"`,
+		"/assets/c.js": testAsset,
+	})
+
+	_, err := Fetcher{BaseURL: server.URL, MaxAssets: 2}.Fetch(context.Background())
+	var notFound *NotFoundError
+	require.ErrorAs(t, err, &notFound)
+	assert.Equal(t, 2, notFound.Scanned)
+	require.Len(t, notFound.Diagnostics, 1)
+	assert.Equal(t, server.URL+"/assets/a.js", notFound.Diagnostics[0].File)
+	assert.Zero(t, requests["/assets/c.js"])
+}
+
+func TestFetcherFetchSkipsFailedAssets(t *testing.T) {
+	t.Parallel()
+
+	server, requests := newTestServer(t, map[string]string{
+		"/app":            `<link rel="prefetch" href="/assets/missing.js"><script src="/assets/main.js"></script>`,
+		"/assets/main.js": testAsset,
+		"/broken/app":     `<script src="/assets/missing.js"></script>`,
+	})
+
+	result, err := Fetcher{BaseURL: server.URL}.Fetch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, server.URL+"/assets/main.js", result.URL)
+	assert.Equal(t, 1, requests["/assets/missing.js"])
+
+	_, err = Fetcher{BaseURL: server.URL + "/broken"}.Fetch(context.Background())
+	var notFound *NotFoundError
+	require.ErrorAs(t, err, &notFound)
+	assert.Zero(t, notFound.Scanned)
+	require.Len(t, notFound.Diagnostics, 1)
+	assert.Equal(t, server.URL+"/assets/missing.js", notFound.Diagnostics[0].File)
+	assert.Contains(t, notFound.Error(), server.URL+"/assets/missing.js: GET ")
+}
+
+func TestFetcherFetchErrors(t *testing.T) {
+	t.Parallel()
+
+	server, _ := newTestServer(t, map[string]string{
+		"/noscript/app": `<html></html>`,
+	})
+
+	_, err := Fetcher{BaseURL: server.URL + "/noscript"}.Fetch(context.Background())
+	assert.ErrorContains(t, err, "no scripts found")
+
+	_, err = Fetcher{BaseURL: server.URL + "/missing"}.Fetch(context.Background())
+	assert.ErrorContains(t, err, "/missing/app: unexpected status 404")
+}
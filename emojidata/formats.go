@@ -0,0 +1,158 @@
+package emojidata
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Format is an output format of the dataset.
+type Format string
+
+// These are the formats supported by Write.
+const (
+	FormatGo         Format = "go"
+	FormatJSON       Format = "json"
+	FormatCSV        Format = "csv"
+	FormatTypeScript Format = "ts"
+)
+
+// Write writes the dataset in the given format. The options are only used
+// for FormatGo. Every format validates the dataset first, see Validate.
+func Write(w io.Writer, dataset Dataset, format Format, opts GoOptions) error {
+	switch format {
+	case FormatGo:
+		return WriteGo(w, dataset, opts)
+	case FormatJSON:
+		return WriteJSON(w, dataset)
+	case FormatCSV:
+		return WriteCSV(w, dataset)
+	case FormatTypeScript:
+		return WriteTypeScript(w, dataset)
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+// WriteJSON writes the entries of the dataset as a JSON array, in the same
+// order as Entries returns them. If the dataset isn't valid, a
+// *ValidationError is returned and nothing is written.
+func WriteJSON(w io.Writer, dataset Dataset) error {
+	if err := Validate(dataset); err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "\t")
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(dataset.Entries())
+}
+
+// WriteCSV writes the dataset as CSV with a header row. Every code is
+// written as a separate row, with the columns code, emoji, primary_code,
+// category, skin_tone_base, name, keywords and version. Rows of aliases have
+// a primary_code different from their code. The keywords are separated by
+// "|", as in CLDR's annotations. The version is empty if it's unknown. Just
+// like the other formats, an invalid dataset isn't written.
+func WriteCSV(w io.Writer, dataset Dataset) error {
+	if err := Validate(dataset); err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"code", "emoji", "primary_code", "category", "skin_tone_base", "name", "keywords", "version"}); err != nil {
+		return err
+	}
+	for _, entry := range dataset.Entries() {
+		keywords := strings.Join(entry.Keywords, "|")
+		var version string
+		if entry.Version > 0 {
+			version = strconv.FormatFloat(entry.Version, 'f', -1, 64)
+		}
+		for _, code := range entry.Codes {
+			if err := writer.Write([]string{code, entry.Emoji, entry.Codes[0], entry.Category, entry.SkinToneBase, entry.Name, keywords, version}); err != nil {
+				return err
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+const typeScriptHeader = `// This file is auto generated: DO NOT EDIT.
+
+export interface EmojiEntry {
+	emoji: string;
+	/** All codes of the emoji, the first one is the primary one. */
+	codes: string[];
+	category: string;
+	/** The emoji without skin tones, if the emoji has skin tones. */
+	skinToneBase?: string;
+	name?: string;
+	keywords?: string[];
+	/** The emoji version the emoji was introduced in. */
+	version?: number;
+}
+
+`
+
+// WriteTypeScript writes the dataset as a TypeScript module, which exports
+// the entries as emojiEntries and the codes mapped to their emoji as
+// emojiMap. The dataset is validated first, as a conflicting name would be
+// a duplicate key in emojiMap.
+func WriteTypeScript(w io.Writer, dataset Dataset) error {
+	if err := Validate(dataset); err != nil {
+		return err
+	}
+
+	buffered := bufio.NewWriter(w)
+	buffered.WriteString(typeScriptHeader)
+
+	entries := dataset.Entries()
+	buffered.WriteString("export const emojiEntries: readonly EmojiEntry[] = [\n")
+	for _, entry := range entries {
+		// JSON is a valid TypeScript expression.
+		literal, err := marshalJSON(entry)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(buffered, "\t%s,\n", literal)
+	}
+	buffered.WriteString("];\n\n")
+
+	buffered.WriteString("export const emojiMap: Readonly<Record<string, string>> = {\n")
+	for _, entry := range entries {
+		emoji, err := marshalJSON(entry.Emoji)
+		if err != nil {
+			return err
+		}
+		for _, code := range entry.Codes {
+			quotedCode, err := marshalJSON(code)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(buffered, "\t%s: %s,\n", quotedCode, emoji)
+		}
+	}
+	buffered.WriteString("};\n")
+
+	return buffered.Flush()
+}
+
+// marshalJSON marshals the value without escaping HTML characters, which
+// json.Marshal does by default.
+func marshalJSON(value any) ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return nil, err
+	}
+	// Encode terminates the value with a newline.
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+}
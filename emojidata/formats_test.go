@@ -0,0 +1,112 @@
+package emojidata
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteJSON(t *testing.T) {
+	t.Parallel()
+
+	dataset, err := ParseAsset(strings.NewReader(testAsset))
+	require.NoError(t, err)
+	dataset.Names = map[string]string{"👍": "thumbs up"}
+
+	var output strings.Builder
+	require.NoError(t, WriteJSON(&output, dataset))
+
+	var entries []Entry
+	require.NoError(t, json.Unmarshal([]byte(output.String()), &entries))
+	assert.Equal(t, dataset.Entries(), entries)
+	assert.Contains(t, output.String(), `"skinToneBase": "👍"`)
+}
+
+func TestWriteCSV(t *testing.T) {
+	t.Parallel()
+
+	dataset, err := ParseAsset(strings.NewReader(testAsset))
+	require.NoError(t, err)
+	dataset.Names = map[string]string{"👍": "thumbs up, yes"}
+	dataset.Keywords = map[string][]string{"🐶": {"dog", "face"}}
+	dataset.Versions = map[string]float64{"😢": 0.6}
+
+	var output strings.Builder
+	require.NoError(t, WriteCSV(&output, dataset))
+	assert.Equal(t, `code,emoji,primary_code,category,skin_tone_base,name,keywords,version
+dog,🐶,dog,nature,,,dog|face,6
+crying,😢,crying,nature,,,,0.6
+cry,😢,crying,nature,,,,0.6
+thumbsup,👍,thumbsup,people,,"thumbs up, yes",,6
++1,👍,thumbsup,people,,"thumbs up, yes",,6
+thumbsup_tone1,👍🏻,thumbsup_tone1,people,👍,,,8
+`, output.String())
+}
+
+func TestWriteTypeScript(t *testing.T) {
+	t.Parallel()
+
+	dataset, err := ParseAsset(strings.NewReader(testAsset))
+	require.NoError(t, err)
+	dataset.Keywords = map[string][]string{"🐶": {"dog", "face"}}
+
+	var output strings.Builder
+	require.NoError(t, WriteTypeScript(&output, dataset))
+	for _, expected := range []string{
+		"export interface EmojiEntry {\n",
+		"export const emojiEntries: readonly EmojiEntry[] = [\n",
+		"\t{\"emoji\":\"🐶\",\"codes\":[\"dog\"],\"category\":\"nature\",\"keywords\":[\"dog\",\"face\"],\"version\":6},\n",
+		"\t{\"emoji\":\"👍🏻\",\"codes\":[\"thumbsup_tone1\"],\"category\":\"people\",\"skinToneBase\":\"👍\",\"version\":8},\n",
+		"export const emojiMap: Readonly<Record<string, string>> = {\n",
+		"\t\"+1\": \"👍\",\n",
+	} {
+		assert.Contains(t, output.String(), expected)
+	}
+}
+
+func TestWriteTypeScriptSkipsDuplicateCodes(t *testing.T) {
+	t.Parallel()
+
+	dataset := Dataset{Groups: EmojiGroups{
+		"nature": {{Names: []string{"cry", "cry"}, Surrogates: "😢"}},
+		"people": {{Names: []string{"cry"}, Surrogates: "😢"}},
+	}}
+
+	var output strings.Builder
+	require.NoError(t, WriteTypeScript(&output, dataset))
+	assert.Equal(t, 1, strings.Count(output.String(), "\t\"cry\": "))
+	assert.Contains(t, output.String(), `"codes":["cry"]`)
+}
+
+func TestWriteRejectsInvalidDataset(t *testing.T) {
+	t.Parallel()
+
+	dataset := Dataset{Groups: EmojiGroups{
+		"nature": {{Names: []string{"cry"}, Surrogates: "😭"}},
+		"people": {{Names: []string{"cry"}, Surrogates: "😢"}},
+	}}
+
+	for _, format := range []Format{FormatGo, FormatJSON, FormatCSV, FormatTypeScript} {
+		t.Run(string(format), func(t *testing.T) {
+			t.Parallel()
+
+			var output strings.Builder
+			err := Write(&output, dataset, format, GoOptions{})
+			var validationError *ValidationError
+			if assert.ErrorAs(t, err, &validationError) {
+				assert.Equal(t, ConflictingName, validationError.Problems[0].Kind)
+			}
+			assert.Empty(t, output.String())
+		})
+	}
+}
+
+func TestWriteUnknownFormat(t *testing.T) {
+	t.Parallel()
+
+	var output strings.Builder
+	assert.Error(t, Write(&output, Dataset{}, Format("yaml"), GoOptions{}))
+}
@@ -0,0 +1,236 @@
+package emojidata
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const goHeader = `package %s
+
+// This file is auto generated: DO NOT EDIT.
+
+var EmojiMap = map[string]string {
+%s}
+`
+
+const goCode = `
+// codesByEmoji maps every emoji to all of its codes. The codes are in the
+// same order as in Discord's data, so the first code is the primary one.
+var codesByEmoji = map[string][]string {
+%s}
+
+// personVariants contains all emoji that have variants differing only in
+// gender or hair style. The variants are derived from the ZWJ structure.
+var personVariants = map[string]personVariant {
+%s}
+
+// skinToneBases maps all emoji with one or more skin tones to the emoji
+// without skin tones.
+var skinToneBases = map[string]string {
+%s}
+
+// emojiCategories maps every emoji to the category it is listed in by
+// Discord's emoji picker. Emoji with skin tones share the category of the
+// emoji without skin tones.
+var emojiCategories = map[string]Category {
+%s}
+
+// cldrNames maps emoji to their CLDR short names, as found in Unicode's
+// emoji-test.txt. It is empty if the mapping was generated without it.
+var cldrNames = map[string]string {
+%s}
+
+// emojiVersions maps emoji to the emoji version they were introduced in, as
+// found in Unicode's emoji-test.txt or, as a fallback, Discord's data.
+var emojiVersions = map[string]float64 {
+%s}
+`
+
+// categoryNames maps Discord's group names to the names of the Category
+// constants in the generated code. Unknown groups are written as plain
+// Category conversions.
+var categoryNames = map[string]string{
+	"activity": "CategoryActivity",
+	"flags":    "CategoryFlags",
+	"food":     "CategoryFood",
+	"nature":   "CategoryNature",
+	"objects":  "CategoryObjects",
+	"people":   "CategoryPeople",
+	"symbols":  "CategorySymbols",
+	"travel":   "CategoryTravel",
+}
+
+// GoOptions configures WriteGo.
+type GoOptions struct {
+	// PackageName is the package of the generated file. It defaults to
+	// "discordemojimap".
+	PackageName string
+	// MapOnly only writes EmojiMap. Other than the full output, this doesn't
+	// depend on the types of discordemojimap and can be used in any package.
+	MapOnly bool
+}
+
+// WriteGo writes the dataset as the Go code of discordemojimap's mapping.go.
+// The dataset is validated first, if it isn't valid, a *ValidationError is
+// returned and nothing is written.
+func WriteGo(w io.Writer, dataset Dataset, opts GoOptions) error {
+	packageName := opts.PackageName
+	if packageName == "" {
+		packageName = "discordemojimap"
+	}
+
+	if err := Validate(dataset); err != nil {
+		return err
+	}
+
+	// Names may be listed more than once for the same emoji, but Go doesn't
+	// allow duplicate keys in map literals.
+	written := make(map[string]bool)
+	var mapping strings.Builder
+	writeEmoji := func(emoji Emoji) {
+		names := make([]string, 0, len(emoji.Names))
+		for _, name := range emoji.Names {
+			if !written[name] {
+				written[name] = true
+				names = append(names, name)
+			}
+		}
+		emoji.Names = names
+		emoji.GoSyntax(&mapping)
+	}
+	for _, name := range dataset.Groups.GroupNames() {
+		for _, emoji := range dataset.Groups[name] {
+			// Write the basic emojis.
+			writeEmoji(emoji)
+
+			// Check if we have toned emojis. Write all of them if we do.
+			for _, toned := range emoji.Diversities {
+				writeEmoji(toned)
+			}
+		}
+	}
+
+	if _, err := fmt.Fprintf(w, goHeader, packageName, mapping.String()); err != nil {
+		return err
+	}
+	if opts.MapOnly {
+		return nil
+	}
+
+	entries := dataset.Entries()
+	emojiOrder := make([]string, len(entries))
+	var codesByEmoji, skinToneBases, categories, cldrNames, versions strings.Builder
+	for index, entry := range entries {
+		emojiOrder[index] = entry.Emoji
+
+		fmt.Fprintf(&codesByEmoji, "\t%+q: {", entry.Emoji)
+		for index, code := range entry.Codes {
+			if index > 0 {
+				codesByEmoji.WriteString(", ")
+			}
+			fmt.Fprintf(&codesByEmoji, "%q", code)
+		}
+		codesByEmoji.WriteString("},\n")
+
+		if entry.SkinToneBase != "" {
+			fmt.Fprintf(&skinToneBases, "\t%+q: %+q,\n", entry.Emoji, entry.SkinToneBase)
+		}
+
+		if constant, known := categoryNames[entry.Category]; known {
+			fmt.Fprintf(&categories, "\t%+q: %s,\n", entry.Emoji, constant)
+		} else {
+			fmt.Fprintf(&categories, "\t%+q: Category(%q),\n", entry.Emoji, entry.Category)
+		}
+
+		if name, exists := dataset.Names[entry.Emoji]; exists {
+			fmt.Fprintf(&cldrNames, "\t%+q: %q,\n", entry.Emoji, name)
+		}
+
+		if entry.Version > 0 {
+			fmt.Fprintf(&versions, "\t%+q: %s,\n", entry.Emoji, strconv.FormatFloat(entry.Version, 'f', -1, 64))
+		}
+	}
+
+	var personVariants strings.Builder
+	if err := writePersonVariants(&personVariants, emojiOrder); err != nil {
+		return fmt.Errorf("failed to derive person variants: %w", err)
+	}
+
+	_, err := fmt.Fprintf(w, goCode, codesByEmoji.String(), personVariants.String(), skinToneBases.String(), categories.String(), cldrNames.String(), versions.String())
+	return err
+}
+
+// ErrEmojiMapNotFound is returned by ParseGoMapping if the code doesn't
+// contain EmojiMap.
+var ErrEmojiMapNotFound = errors.New("EmojiMap not found")
+
+// ParseGoMapping parses EmojiMap from Go code, such as discordemojimap's
+// mapping.go, and returns the codes mapped to their emoji. The code doesn't
+// have to be formatted the way WriteGo writes it, so gofmt'd files work as
+// well.
+func ParseGoMapping(r io.Reader) (map[string]string, error) {
+	source, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, "", source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, declaration := range file.Decls {
+		genDecl, ok := declaration.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			for index, name := range valueSpec.Names {
+				if name.Name != "EmojiMap" || index >= len(valueSpec.Values) {
+					continue
+				}
+				literal, ok := valueSpec.Values[index].(*ast.CompositeLit)
+				if !ok {
+					return nil, fmt.Errorf("%s: EmojiMap isn't a map literal", fileSet.Position(valueSpec.Values[index].Pos()))
+				}
+				return parseMapLiteral(fileSet, literal)
+			}
+		}
+	}
+	return nil, ErrEmojiMapNotFound
+}
+
+func parseMapLiteral(fileSet *token.FileSet, literal *ast.CompositeLit) (map[string]string, error) {
+	mapping := make(map[string]string, len(literal.Elts))
+	for _, element := range literal.Elts {
+		keyValue, ok := element.(*ast.KeyValueExpr)
+		if !ok {
+			return nil, fmt.Errorf("%s: malformed entry", fileSet.Position(element.Pos()))
+		}
+		code, err := unquoteStringLiteral(keyValue.Key)
+		if err != nil {
+			return nil, fmt.Errorf("%s: malformed code: %w", fileSet.Position(keyValue.Key.Pos()), err)
+		}
+		emoji, err := unquoteStringLiteral(keyValue.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: malformed emoji: %w", fileSet.Position(keyValue.Value.Pos()), err)
+		}
+		mapping[code] = emoji
+	}
+	return mapping, nil
+}
+
+func unquoteStringLiteral(expression ast.Expr) (string, error) {
+	literal, ok := expression.(*ast.BasicLit)
+	if !ok || literal.Kind != token.STRING {
+		return "", errors.New("not a string literal")
+	}
+	return strconv.Unquote(literal.Value)
+}
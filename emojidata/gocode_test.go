@@ -0,0 +1,56 @@
+package emojidata
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteGo(t *testing.T) {
+	t.Parallel()
+
+	dataset, err := ParseAsset(strings.NewReader(testAsset))
+	require.NoError(t, err)
+	dataset.Names = map[string]string{"😢": "crying face"}
+	dataset.Versions = map[string]float64{"😢": 0.6}
+
+	var code strings.Builder
+	require.NoError(t, WriteGo(&code, dataset, GoOptions{}))
+	for _, expected := range []string{
+		"package discordemojimap\n",
+		"\t\"+1\": \"\\U0001f44d\",\n",
+		"\t\"\\U0001f622\": {\"crying\", \"cry\"},\n",
+		"\t\"\\U0001f44d\\U0001f3fb\": \"\\U0001f44d\",\n",
+		"\t\"\\U0001f436\": CategoryNature,\n",
+		"\t\"\\U0001f622\": \"crying face\",\n",
+		"\t\"\\U0001f622\": 0.6,\n",
+		"\t\"\\U0001f44d\\U0001f3fb\": 8,\n",
+	} {
+		assert.Contains(t, code.String(), expected)
+	}
+}
+
+func TestWriteGoMapOnly(t *testing.T) {
+	t.Parallel()
+
+	dataset, err := ParseAsset(strings.NewReader(testAsset))
+	require.NoError(t, err)
+
+	var code strings.Builder
+	require.NoError(t, WriteGo(&code, dataset, GoOptions{PackageName: "emoji", MapOnly: true}))
+	assert.Equal(t, `package emoji
+
+// This file is auto generated: DO NOT EDIT.
+
+var EmojiMap = map[string]string {
+	"dog": "\U0001f436",
+	"crying": "\U0001f622",
+	"thumbsup": "\U0001f44d",
+	"+1": "\U0001f44d",
+	"thumbsup_tone1": "\U0001f44d\U0001f3fb",
+	"cry": "\U0001f622",
+}
+`, code.String())
+}
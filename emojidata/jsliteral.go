@@ -0,0 +1,311 @@
+package emojidata
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// jsParser parses the subset of JavaScript literals that bundlers use for
+// embedded data: objects, arrays, strings, numbers, booleans, null and the
+// minified booleans "!0" and "!1". The values are returned in the form
+// produced by encoding/json, so they can be marshalled as JSON.
+type jsParser struct {
+	input  []byte
+	offset int
+}
+
+// parseJSString parses the string literal starting at the given offset and
+// returns the unescaped string and the offset after the closing quote.
+func parseJSString(input []byte, offset int) (string, int, error) {
+	parser := jsParser{input: input, offset: offset}
+	value, err := parser.parseString()
+	return value, parser.offset, err
+}
+
+// parseJSValue parses the literal starting at the given offset and returns
+// its value and the offset after the literal.
+func parseJSValue(input []byte, offset int) (any, int, error) {
+	parser := jsParser{input: input, offset: offset}
+	value, err := parser.parseValue()
+	return value, parser.offset, err
+}
+
+func (p *jsParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("offset %d: %s", p.offset, fmt.Sprintf(format, args...))
+}
+
+func (p *jsParser) skipWhitespace() {
+	for p.offset < len(p.input) {
+		switch p.input[p.offset] {
+		case ' ', '\t', '\n', '\r':
+			p.offset++
+		default:
+			return
+		}
+	}
+}
+
+func (p *jsParser) peek() byte {
+	if p.offset >= len(p.input) {
+		return 0
+	}
+	return p.input[p.offset]
+}
+
+func (p *jsParser) parseValue() (any, error) {
+	p.skipWhitespace()
+	switch c := p.peek(); {
+	case c == '{':
+		return p.parseObject()
+	case c == '[':
+		return p.parseArray()
+	case c == '"' || c == '\'' || c == '`':
+		return p.parseString()
+	case c == '!':
+		// Minifiers write true as !0 and false as !1.
+		if p.offset+1 < len(p.input) && (p.input[p.offset+1] == '0' || p.input[p.offset+1] == '1') {
+			value := p.input[p.offset+1] == '0'
+			p.offset += 2
+			return value, nil
+		}
+		return nil, p.errorf("unexpected %q", c)
+	case c == '-' || c == '.' || (c >= '0' && c <= '9'):
+		return p.parseNumber()
+	case isIdentifierStart(c):
+		switch identifier := p.parseIdentifier(); identifier {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		default:
+			return nil, p.errorf("unsupported identifier %q", identifier)
+		}
+	case c == 0:
+		return nil, p.errorf("unexpected end of input")
+	default:
+		return nil, p.errorf("unexpected %q", c)
+	}
+}
+
+func (p *jsParser) parseObject() (map[string]any, error) {
+	// Skip the opening brace.
+	p.offset++
+	object := make(map[string]any)
+	for {
+		p.skipWhitespace()
+		var key string
+		switch c := p.peek(); {
+		case c == '}':
+			p.offset++
+			return object, nil
+		case c == '"' || c == '\'':
+			var err error
+			if key, err = p.parseString(); err != nil {
+				return nil, err
+			}
+		case isIdentifierStart(c) || (c >= '0' && c <= '9'):
+			key = p.parseIdentifier()
+		default:
+			return nil, p.errorf("expected object key, got %q", c)
+		}
+
+		p.skipWhitespace()
+		if p.peek() != ':' {
+			return nil, p.errorf("expected ':' after key %q", key)
+		}
+		p.offset++
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		object[key] = value
+
+		p.skipWhitespace()
+		switch c := p.peek(); c {
+		case ',':
+			p.offset++
+		case '}':
+		default:
+			return nil, p.errorf("expected ',' or '}', got %q", c)
+		}
+	}
+}
+
+func (p *jsParser) parseArray() ([]any, error) {
+	// Skip the opening bracket.
+	p.offset++
+	array := []any{}
+	for {
+		p.skipWhitespace()
+		if p.peek() == ']' {
+			p.offset++
+			return array, nil
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		array = append(array, value)
+
+		p.skipWhitespace()
+		switch c := p.peek(); c {
+		case ',':
+			p.offset++
+		case ']':
+		default:
+			return nil, p.errorf("expected ',' or ']', got %q", c)
+		}
+	}
+}
+
+func (p *jsParser) parseNumber() (float64, error) {
+	start := p.offset
+	for ; p.offset < len(p.input); p.offset++ {
+		c := p.input[p.offset]
+		if !(c >= '0' && c <= '9') && c != '-' && c != '+' && c != '.' && c != 'e' && c != 'E' {
+			break
+		}
+	}
+	literal := string(p.input[start:p.offset])
+	number, err := strconv.ParseFloat(literal, 64)
+	if err != nil {
+		p.offset = start
+		return 0, p.errorf("invalid number %q", literal)
+	}
+	return number, nil
+}
+
+func (p *jsParser) parseIdentifier() string {
+	start := p.offset
+	for p.offset < len(p.input) && (isIdentifierStart(p.input[p.offset]) || (p.input[p.offset] >= '0' && p.input[p.offset] <= '9')) {
+		p.offset++
+	}
+	return string(p.input[start:p.offset])
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// parseString parses a string literal in single quotes, double quotes or
+// backticks. Template literals with substitutions aren't supported.
+func (p *jsParser) parseString() (string, error) {
+	start := p.offset
+	quote := p.peek()
+	p.offset++
+
+	var value strings.Builder
+	for {
+		if p.offset >= len(p.input) {
+			p.offset = start
+			return "", p.errorf("unterminated string")
+		}
+
+		c := p.input[p.offset]
+		switch {
+		case c == quote:
+			p.offset++
+			return value.String(), nil
+		case c == '\n' && quote != '`':
+			return "", p.errorf("newline in string")
+		case c == '$' && quote == '`' && p.offset+1 < len(p.input) && p.input[p.offset+1] == '{':
+			return "", p.errorf("template literal substitutions aren't supported")
+		case c != '\\':
+			value.WriteByte(c)
+			p.offset++
+			continue
+		}
+
+		// Skip the backslash.
+		p.offset++
+		if p.offset >= len(p.input) {
+			continue
+		}
+		escaped := p.input[p.offset]
+		p.offset++
+		switch escaped {
+		case 'n':
+			value.WriteByte('\n')
+		case 'r':
+			value.WriteByte('\r')
+		case 't':
+			value.WriteByte('\t')
+		case 'b':
+			value.WriteByte('\b')
+		case 'f':
+			value.WriteByte('\f')
+		case 'v':
+			value.WriteByte('\v')
+		case '0':
+			value.WriteByte(0)
+		case '\n':
+			// Line continuation.
+		case 'x':
+			r, err := p.parseHex(2)
+			if err != nil {
+				return "", err
+			}
+			value.WriteRune(r)
+		case 'u':
+			r, err := p.parseUnicodeEscape()
+			if err != nil {
+				return "", err
+			}
+			// Characters outside the BMP are escaped as surrogate pairs.
+			if utf16.IsSurrogate(r) && strings.HasPrefix(string(p.input[p.offset:min(p.offset+2, len(p.input))]), `\u`) {
+				offset := p.offset
+				p.offset += 2
+				low, err := p.parseUnicodeEscape()
+				if err != nil {
+					return "", err
+				}
+				if combined := utf16.DecodeRune(r, low); combined != utf8.RuneError {
+					r = combined
+				} else {
+					p.offset = offset
+				}
+			}
+			value.WriteRune(r)
+		default:
+			// Everything else, such as quotes and backslashes, escapes
+			// itself.
+			value.WriteByte(escaped)
+		}
+	}
+}
+
+// parseUnicodeEscape parses the part of a \u escape following the "u",
+// either four hex digits or hex digits in braces.
+func (p *jsParser) parseUnicodeEscape() (rune, error) {
+	if p.peek() != '{' {
+		return p.parseHex(4)
+	}
+
+	end := p.offset + 1
+	for end < len(p.input) && p.input[end] != '}' {
+		end++
+	}
+	codepoint, err := strconv.ParseUint(string(p.input[p.offset+1:min(end, len(p.input))]), 16, 32)
+	if err != nil || end >= len(p.input) {
+		return 0, p.errorf("invalid unicode escape")
+	}
+	p.offset = end + 1
+	return rune(codepoint), nil
+}
+
+func (p *jsParser) parseHex(digits int) (rune, error) {
+	if p.offset+digits > len(p.input) {
+		return 0, p.errorf("truncated escape sequence")
+	}
+	codepoint, err := strconv.ParseUint(string(p.input[p.offset:p.offset+digits]), 16, 32)
+	if err != nil {
+		return 0, p.errorf("invalid escape sequence %q", p.input[p.offset:p.offset+digits])
+	}
+	p.offset += digits
+	return rune(codepoint), nil
+}
@@ -0,0 +1,69 @@
+package emojidata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJSString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "single quotes", input: `'it\'s'`, want: "it's"},
+		{name: "double quotes", input: `"say \"hi\""`, want: `say "hi"`},
+		{name: "control characters", input: `'a\n\tb\\'`, want: "a\n\tb\\"},
+		{name: "hex escape", input: `'\x41'`, want: "A"},
+		{name: "surrogate pair", input: `'\ud83d\ude22'`, want: "😢"},
+		{name: "codepoint escape", input: `'\u{1F622}'`, want: "😢"},
+		{name: "escaped backslash", input: `'\\u00e9'`, want: `\u00e9`},
+		{name: "literal utf-8", input: `'😢'`, want: "😢"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, end, err := parseJSString([]byte(tt.input+"rest"), 0)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, len(tt.input), end)
+		})
+	}
+}
+
+func TestParseJSValue(t *testing.T) {
+	t.Parallel()
+
+	input := `{a:[1,-2.5e1,!0,!1,null,true,false,],'b':"c",}`
+	got, end, err := parseJSValue([]byte(input+"x"), 0)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"a": []any{1.0, -25.0, true, false, nil, true, false},
+		"b": "c",
+	}, got)
+	assert.Equal(t, len(input), end)
+}
+
+func TestParseJSValueInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{
+		`{a:1`,
+		`{a 1}`,
+		`[1 2]`,
+		`{a:undefined}`,
+		`'unterminated`,
+		"`${a}`",
+		`'\u12'`,
+		`{a:1..2}`,
+		`!2`,
+	} {
+		_, _, err := parseJSValue([]byte(input), 0)
+		assert.Error(t, err, input)
+	}
+}
@@ -0,0 +1,145 @@
+package emojidata
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// ProblemKind is the kind of problem found by Validate.
+type ProblemKind string
+
+// These are the problems reported by Validate.
+const (
+	// ConflictingName is a name used for more than one emoji.
+	ConflictingName ProblemKind = "conflicting name"
+	// UnmatchableName is a name that Replace can never match, since it is
+	// empty, contains a colon or contains uppercase letters. Replace
+	// lowercases codes before looking them up.
+	UnmatchableName ProblemKind = "unmatchable name"
+	// EmptySurrogates is an emoji without surrogates.
+	EmptySurrogates ProblemKind = "empty surrogates"
+	// InvalidUTF8 is a name or emoji that isn't valid UTF-8, or contains the
+	// replacement character U+FFFD, which the JSON decoder uses for invalid
+	// UTF-8 and unpaired surrogates.
+	InvalidUTF8 ProblemKind = "invalid UTF-8"
+)
+
+// Problem is a single problem found by Validate.
+type Problem struct {
+	Kind ProblemKind
+	// Group is the group the emoji is listed in.
+	Group string
+	Name  string
+	Emoji string
+	// Message describes the problem in detail.
+	Message string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%s: %s", p.Kind, p.Message)
+}
+
+// ValidationError is returned by Validate and contains all problems found.
+type ValidationError struct {
+	Problems []Problem
+}
+
+func (e *ValidationError) Error() string {
+	var message strings.Builder
+	fmt.Fprintf(&message, "dataset has %d problem(s)", len(e.Problems))
+	for _, problem := range e.Problems {
+		message.WriteString("\n\t")
+		message.WriteString(problem.String())
+	}
+	return message.String()
+}
+
+// Validate checks the dataset for entries that would result in a broken
+// mapping. Names listed more than once for the same emoji are fine, but a
+// name used for different emoji is reported, since only one of them could
+// be kept. If there are problems, a *ValidationError is returned.
+func Validate(dataset Dataset) error {
+	type origin struct {
+		emoji string
+		group string
+	}
+	names := make(map[string]origin)
+
+	var problems []Problem
+	check := func(emoji Emoji, group string) {
+		switch {
+		case emoji.Surrogates == "":
+			problems = append(problems, Problem{
+				Kind:    EmptySurrogates,
+				Group:   group,
+				Name:    strings.Join(emoji.Names, ", "),
+				Message: fmt.Sprintf("emoji with names %q in group %q has no surrogates", emoji.Names, group),
+			})
+		case !isValidText(emoji.Surrogates):
+			problems = append(problems, Problem{
+				Kind:    InvalidUTF8,
+				Group:   group,
+				Emoji:   emoji.Surrogates,
+				Message: fmt.Sprintf("emoji %+q with names %q in group %q", emoji.Surrogates, emoji.Names, group),
+			})
+		}
+
+		for _, name := range emoji.Names {
+			switch {
+			case !isValidText(name):
+				problems = append(problems, Problem{
+					Kind:    InvalidUTF8,
+					Group:   group,
+					Name:    name,
+					Emoji:   emoji.Surrogates,
+					Message: fmt.Sprintf("name %+q of %+q in group %q", name, emoji.Surrogates, group),
+				})
+			case name == "" || strings.ContainsRune(name, ':') || strings.ToLower(name) != name:
+				problems = append(problems, Problem{
+					Kind:    UnmatchableName,
+					Group:   group,
+					Name:    name,
+					Emoji:   emoji.Surrogates,
+					Message: fmt.Sprintf("name %q of %+q in group %q can't be matched by Replace", name, emoji.Surrogates, group),
+				})
+			}
+
+			previous, exists := names[name]
+			if !exists {
+				names[name] = origin{emoji: emoji.Surrogates, group: group}
+				continue
+			}
+			if previous.emoji != emoji.Surrogates {
+				problems = append(problems, Problem{
+					Kind:  ConflictingName,
+					Group: group,
+					Name:  name,
+					Emoji: emoji.Surrogates,
+					Message: fmt.Sprintf("name %q is used for %+q in group %q and for %+q in group %q",
+						name, previous.emoji, previous.group, emoji.Surrogates, group),
+				})
+			}
+		}
+	}
+
+	for _, group := range dataset.Groups.GroupNames() {
+		for _, emoji := range dataset.Groups[group] {
+			check(emoji, group)
+			for _, toned := range emoji.Diversities {
+				check(toned, group)
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// isValidText returns true if the text is valid UTF-8 and doesn't contain
+// replacement characters.
+func isValidText(text string) bool {
+	return utf8.ValidString(text) && !strings.ContainsRune(text, utf8.RuneError)
+}
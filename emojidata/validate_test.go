@@ -0,0 +1,118 @@
+package emojidata
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		groups EmojiGroups
+		want   []ProblemKind
+	}{
+		{
+			name: "valid",
+			groups: EmojiGroups{
+				"people": {{Names: []string{"cry", "piñata", "+1"}, Surrogates: "😢"}},
+				"nature": {{Names: []string{"cry"}, Surrogates: "😢"}},
+			},
+		},
+		{
+			name: "conflicting names across groups",
+			groups: EmojiGroups{
+				"nature": {{Names: []string{"cry"}, Surrogates: "😭"}},
+				"people": {{Names: []string{"cry"}, Surrogates: "😢"}},
+			},
+			want: []ProblemKind{ConflictingName},
+		},
+		{
+			name: "conflicting names with skin tones",
+			groups: EmojiGroups{
+				"people": {{Names: []string{"thumbsup"}, Surrogates: "👍", Diversities: []Emoji{
+					{Names: []string{"thumbsup"}, Surrogates: "👍🏻"},
+				}}},
+			},
+			want: []ProblemKind{ConflictingName},
+		},
+		{
+			name: "unmatchable names",
+			groups: EmojiGroups{
+				"people": {{Names: []string{"", "a:b", "Cry"}, Surrogates: "😢"}},
+			},
+			want: []ProblemKind{UnmatchableName, UnmatchableName, UnmatchableName},
+		},
+		{
+			name:   "empty surrogates",
+			groups: EmojiGroups{"people": {{Names: []string{"cry"}}}},
+			want:   []ProblemKind{EmptySurrogates},
+		},
+		{
+			name: "invalid UTF-8",
+			groups: EmojiGroups{"people": {
+				{Names: []string{"cry\xff"}, Surrogates: "😢"},
+				{Names: []string{"sob"}, Surrogates: "\ufffd"},
+			}},
+			want: []ProblemKind{InvalidUTF8, InvalidUTF8},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Validate(Dataset{Groups: tt.groups})
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var validationError *ValidationError
+			require.ErrorAs(t, err, &validationError)
+			var kinds []ProblemKind
+			for _, problem := range validationError.Problems {
+				kinds = append(kinds, problem.Kind)
+			}
+			assert.Equal(t, tt.want, kinds)
+		})
+	}
+}
+
+func TestValidationErrorMessage(t *testing.T) {
+	t.Parallel()
+
+	err := Validate(Dataset{Groups: EmojiGroups{
+		"nature": {{Names: []string{"cry"}, Surrogates: "😭"}},
+		"people": {{Names: []string{"cry"}, Surrogates: "😢"}},
+	}})
+	assert.EqualError(t, err, `dataset has 1 problem(s)
+	conflicting name: name "cry" is used for "\U0001f62d" in group "nature" and for "\U0001f622" in group "people"`)
+}
+
+func TestWriteGoRejectsInvalidDataset(t *testing.T) {
+	t.Parallel()
+
+	var code strings.Builder
+	err := WriteGo(&code, Dataset{Groups: EmojiGroups{"people": {{Names: []string{"cry"}}}}}, GoOptions{})
+	var validationError *ValidationError
+	assert.ErrorAs(t, err, &validationError)
+	assert.Empty(t, code.String())
+}
+
+func TestWriteGoSkipsDuplicateNames(t *testing.T) {
+	t.Parallel()
+
+	dataset := Dataset{Groups: EmojiGroups{
+		"nature": {{Names: []string{"cry"}, Surrogates: "😢"}},
+		"people": {{Names: []string{"cry", "sad"}, Surrogates: "😢"}},
+	}}
+
+	var code strings.Builder
+	require.NoError(t, WriteGo(&code, dataset, GoOptions{}))
+	assert.Equal(t, 1, strings.Count(code.String(), "\t\"cry\": "))
+	assert.Contains(t, code.String(), "\t\"\\U0001f622\": {\"cry\", \"sad\"},\n")
+}
@@ -0,0 +1,134 @@
+package emojidata
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	zeroWidthJoiner   = "\u200d"
+	variationSelector = "\ufe0f"
+	person            = "\U0001f9d1"
+	man               = "\U0001f468"
+	woman             = "\U0001f469"
+)
+
+// genderSigns maps the gender signs used in ZWJ sequences to the names of
+// the Gender constants in the generated code.
+var genderSigns = map[string]string{
+	"\u2642": "GenderMan",
+	"\u2640": "GenderWoman",
+}
+
+// personGenders maps the person emoji to the names of the Gender constants
+// in the generated code.
+var personGenders = map[string]string{
+	person: "GenderNeutral",
+	man:    "GenderMan",
+	woman:  "GenderWoman",
+}
+
+// hairStyles maps the hair components to the names of the HairStyle
+// constants in the generated code.
+var hairStyles = map[string]string{
+	"\U0001f9b0": "HairRed",
+	"\U0001f9b1": "HairCurly",
+	"\U0001f9b2": "HairBald",
+	"\U0001f9b3": "HairWhite",
+}
+
+// children are person emoji that form families rather than variants when
+// joined with a person.
+var children = map[string]bool{
+	"\U0001f466": true,
+	"\U0001f467": true,
+	"\U0001f9d2": true,
+	person:       true,
+	man:          true,
+	woman:        true,
+}
+
+// personVariant describes an emoji as a variant of a family of emoji that
+// only differ in gender and hair style.
+type personVariant struct {
+	family string
+	gender string
+	hair   string
+}
+
+// derivePersonVariant derives the family, gender and hair style of an emoji
+// from its ZWJ structure. There are two kinds of variants:
+//
+//   - An emoji followed by a gender sign, such as "🏃‍♀️", is a variant of the
+//     emoji without the gender sign.
+//   - Emoji starting with "🧑", "👨" or "👩", such as "👩‍⚕️" or "👨‍🦰", are
+//     variants of the same emoji starting with "🧑". If the second part is a
+//     hair component, it's a hair style variant of "🧑".
+//
+// Skin tones are kept as part of the family, so that variants keep the skin
+// tone of the emoji.
+func derivePersonVariant(emoji string) personVariant {
+	parts := strings.Split(strings.ReplaceAll(emoji, variationSelector, ""), zeroWidthJoiner)
+	if len(parts) > 2 {
+		return personVariant{}
+	}
+
+	base, skinTone := splitSkinTone(parts[0])
+	if gender, isPerson := personGenders[base]; isPerson {
+		if len(parts) == 1 {
+			return personVariant{family: person + skinTone, gender: gender, hair: "HairDefault"}
+		}
+		if children[parts[1]] {
+			return personVariant{}
+		}
+		if hair, isHair := hairStyles[parts[1]]; isHair {
+			return personVariant{family: person + skinTone, gender: gender, hair: hair}
+		}
+		return personVariant{family: person + skinTone + zeroWidthJoiner + parts[1], gender: gender, hair: "HairDefault"}
+	}
+
+	if len(parts) == 1 {
+		return personVariant{family: parts[0], gender: "GenderNeutral", hair: "HairDefault"}
+	}
+	if gender, isGenderSign := genderSigns[parts[1]]; isGenderSign {
+		return personVariant{family: parts[0], gender: gender, hair: "HairDefault"}
+	}
+	return personVariant{}
+}
+
+// splitSkinTone splits a single emoji into its base and skin tone modifier.
+func splitSkinTone(emoji string) (string, string) {
+	for index, r := range emoji {
+		if r >= '\U0001f3fb' && r <= '\U0001f3ff' {
+			return emoji[:index], emoji[index:]
+		}
+	}
+	return emoji, ""
+}
+
+// writePersonVariants writes the entries of the personVariants map. Only
+// emoji that have at least one other variant are written.
+func writePersonVariants(w io.Writer, emojiOrder []string) error {
+	variants := make(map[string]personVariant, len(emojiOrder))
+	familySizes := make(map[string]int)
+	for _, emoji := range emojiOrder {
+		variant := derivePersonVariant(emoji)
+		if variant.family == "" {
+			continue
+		}
+		variants[emoji] = variant
+		familySizes[variant.family]++
+	}
+
+	for _, emoji := range emojiOrder {
+		variant, exists := variants[emoji]
+		if !exists || familySizes[variant.family] < 2 {
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "\t%+q: {%+q, %s, %s},\n", emoji, variant.family, variant.gender, variant.hair); err != nil {
+			return err
+		}
+	}
+	return nil
+}
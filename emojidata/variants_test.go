@@ -0,0 +1,32 @@
+package emojidata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDerivePersonVariant(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		emoji string
+		want  personVariant
+	}{
+		{name: "person", emoji: person, want: personVariant{family: person, gender: "GenderNeutral", hair: "HairDefault"}},
+		{name: "woman with skin tone", emoji: woman + "\U0001f3fd", want: personVariant{family: person + "\U0001f3fd", gender: "GenderWoman", hair: "HairDefault"}},
+		{name: "hair style", emoji: man + zeroWidthJoiner + "\U0001f9b0", want: personVariant{family: person, gender: "GenderMan", hair: "HairRed"}},
+		{name: "profession", emoji: woman + zeroWidthJoiner + "\U0001f4bb", want: personVariant{family: person + zeroWidthJoiner + "\U0001f4bb", gender: "GenderWoman", hair: "HairDefault"}},
+		{name: "gender sign", emoji: "\U0001f3c3" + zeroWidthJoiner + "♀" + variationSelector, want: personVariant{family: "\U0001f3c3", gender: "GenderWoman", hair: "HairDefault"}},
+		{name: "family", emoji: man + zeroWidthJoiner + woman + zeroWidthJoiner + "\U0001f466", want: personVariant{}},
+		{name: "couple", emoji: woman + zeroWidthJoiner + man, want: personVariant{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, derivePersonVariant(tt.emoji))
+		})
+	}
+}
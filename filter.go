@@ -0,0 +1,53 @@
+package discordemojimap
+
+// Filter matches emoji by code, category or the emoji itself. An emoji
+// matches the filter if it matches any of the entries. Codes and emoji also
+// match the variants of the emoji with skin tones, so that "thumbsup"
+// matches ":thumbsup_tone3:" as well.
+//
+// The zero value matches no emoji.
+type Filter struct {
+	// Codes are emoji codes without colons, such as "thumbsup". Any code of
+	// an emoji matches, not only the one used in the input. The codes are
+	// case-insensitive.
+	Codes []string
+	// Categories are the categories of Discord's emoji picker.
+	Categories []Category
+	// Emoji are unicode emoji, such as "👍". Emoji without variation
+	// selector are matched as well.
+	Emoji []string
+}
+
+// IsEmpty returns true if the filter has no entries.
+func (f Filter) IsEmpty() bool {
+	return len(f.Codes) == 0 && len(f.Categories) == 0 && len(f.Emoji) == 0
+}
+
+// Matches returns true if the emoji matches any entry of the filter.
+func (f Filter) Matches(emoji string) bool {
+	canonical, exists := emojiIndexLookup(emoji)
+	if !exists {
+		return false
+	}
+	base := baseEmoji(canonical)
+
+	for _, code := range f.Codes {
+		if mapped := GetEmoji(code); mapped != "" && (mapped == canonical || mapped == base) {
+			return true
+		}
+	}
+	if len(f.Categories) > 0 {
+		category := emojiCategories[canonical]
+		for _, candidate := range f.Categories {
+			if candidate == category {
+				return true
+			}
+		}
+	}
+	for _, candidate := range f.Emoji {
+		if mapped, exists := emojiIndexLookup(candidate); exists && (mapped == canonical || mapped == base) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package discordemojimap
+
+import "strings"
+
+const (
+	regionalIndicatorA = '\U0001f1e6'
+	blackFlag          = '\U0001f3f4'
+	tagA               = '\U000e0061'
+	cancelTag          = '\U000e007f'
+)
+
+// FlagForCountry returns the flag emoji for an ISO 3166-1 alpha-2 country
+// code, such as "🇩🇪" for "DE". Subdivision flags can be retrieved using
+// their ISO 3166-2 code, such as "GB-ENG" for England, "GB-SCT" for Scotland
+// and "GB-WLS" for Wales. The search is case-insensitive.
+//
+// If the code isn't a country code or a subdivision code, or Discord doesn't
+// have a flag for it, an empty string is returned.
+func FlagForCountry(countryCode string) string {
+	country, subdivision, isSubdivision := strings.Cut(countryCode, "-")
+	if len(country) != 2 || (isSubdivision && (len(subdivision) == 0 || len(subdivision) > 3)) {
+		return ""
+	}
+	code := strings.ToLower(country + subdivision)
+	if !isLowerASCIILetters(code) {
+		return ""
+	}
+
+	var flag strings.Builder
+	if len(code) == 2 {
+		flag.WriteRune(regionalIndicatorA + rune(code[0]-'a'))
+		flag.WriteRune(regionalIndicatorA + rune(code[1]-'a'))
+	} else {
+		// Subdivision flags are a black flag followed by the lowercase code
+		// as tag characters, terminated by a cancel tag.
+		flag.WriteRune(blackFlag)
+		for index := 0; index < len(code); index++ {
+			flag.WriteRune(tagA + rune(code[index]-'a'))
+		}
+		flag.WriteRune(cancelTag)
+	}
+
+	if _, exists := codesByEmoji[flag.String()]; !exists {
+		return ""
+	}
+	return flag.String()
+}
+
+// CountryForFlag returns the uppercase ISO 3166-1 alpha-2 country code for a
+// flag emoji, such as "DE" for "🇩🇪". For subdivision flags, the ISO 3166-2
+// code is returned, such as "GB-ENG" for England.
+//
+// If the input isn't a flag known to Discord, an empty string is returned.
+func CountryForFlag(flag string) string {
+	if _, exists := codesByEmoji[flag]; !exists {
+		return ""
+	}
+
+	runes := []rune(flag)
+	if len(runes) == 2 && isRegionalIndicator(runes[0]) && isRegionalIndicator(runes[1]) {
+		return string([]rune{'A' + runes[0] - regionalIndicatorA, 'A' + runes[1] - regionalIndicatorA})
+	}
+
+	if len(runes) < 5 || runes[0] != blackFlag || runes[len(runes)-1] != cancelTag {
+		return ""
+	}
+	var code strings.Builder
+	for index, r := range runes[1 : len(runes)-1] {
+		if r < tagA || r > tagA+'z'-'a' {
+			return ""
+		}
+		if index == 2 {
+			code.WriteByte('-')
+		}
+		code.WriteRune('A' + r - tagA)
+	}
+	return code.String()
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= regionalIndicatorA && r <= regionalIndicatorA+'z'-'a'
+}
+
+func isLowerASCIILetters(input string) bool {
+	for index := 0; index < len(input); index++ {
+		if input[index] < 'a' || input[index] > 'z' {
+			return false
+		}
+	}
+	return true
+}
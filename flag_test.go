@@ -0,0 +1,100 @@
+package discordemojimap
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestFlagForCountry(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		countryCode string
+		want        string
+	}{
+		{countryCode: "", want: ""},
+		{countryCode: "D", want: ""},
+		{countryCode: "D1", want: ""},
+		{countryCode: "ÄÖ", want: ""},
+		{countryCode: "XX", want: ""},
+		{countryCode: "DE", want: "🇩🇪"},
+		{countryCode: "de", want: "🇩🇪"},
+		{countryCode: "Gb", want: "🇬🇧"},
+		{countryCode: "GB-ENG", want: GetEmoji("england")},
+		{countryCode: "gb-sct", want: GetEmoji("scotland")},
+		{countryCode: "gb-wls", want: GetEmoji("wales")},
+		{countryCode: "GBWLS", want: ""},
+		{countryCode: "D-E", want: ""},
+		{countryCode: "-DE-", want: ""},
+		{countryCode: "DE-", want: ""},
+		{countryCode: "GB-EN-G", want: ""},
+		{countryCode: "GB-ENGL", want: ""},
+		{countryCode: "DE-BY", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.countryCode, func(t *testing.T) {
+			t.Parallel()
+
+			if got := FlagForCountry(tt.countryCode); got != tt.want {
+				t.Errorf("FlagForCountry(%q) = %q, want %q", tt.countryCode, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCountryForFlag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		flag string
+		want string
+	}{
+		{flag: "", want: ""},
+		{flag: "DE", want: ""},
+		{flag: "😢", want: ""},
+		{flag: "🏴", want: ""},
+		{flag: GetEmoji("rainbow_flag"), want: ""},
+		{flag: GetEmoji("regional_indicator_d"), want: ""},
+		{flag: "🇩🇪", want: "DE"},
+		{flag: "🇩🇪🇩🇪", want: ""},
+		{flag: GetEmoji("england"), want: "GB-ENG"},
+		{flag: GetEmoji("scotland"), want: "GB-SCT"},
+		{flag: GetEmoji("wales"), want: "GB-WLS"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.flag, func(t *testing.T) {
+			t.Parallel()
+
+			if got := CountryForFlag(tt.flag); got != tt.want {
+				t.Errorf("CountryForFlag(%q) = %q, want %q", tt.flag, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlagForCountryMatchesFlagCodes(t *testing.T) {
+	t.Parallel()
+
+	for code, emoji := range EmojiMap {
+		countryCode, isFlag := strings.CutPrefix(code, "flag_")
+		if !isFlag || len(countryCode) != 2 {
+			continue
+		}
+
+		if got := FlagForCountry(countryCode); got != emoji {
+			t.Errorf("FlagForCountry(%q) = %q, want %q", countryCode, got, emoji)
+		}
+		if got := CountryForFlag(emoji); got != strings.ToUpper(countryCode) {
+			t.Errorf("CountryForFlag(%q) = %q, want %q", emoji, got, strings.ToUpper(countryCode))
+		}
+	}
+}
+
+func ExampleFlagForCountry() {
+	fmt.Println(FlagForCountry("DE"))
+	fmt.Println(CountryForFlag("🇩🇪"))
+	// Output:
+	// 🇩🇪
+	// DE
+}
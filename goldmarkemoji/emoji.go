@@ -0,0 +1,188 @@
+// Package goldmarkemoji provides a goldmark extension that renders Discord
+// emoji codes such as ":cry:" and custom emoji markup such as "<:pepe:123>".
+//
+// Since the emoji are parsed as inline nodes, code spans and code blocks are
+// left untouched:
+//
+//	markdown := goldmark.New(goldmark.WithExtensions(goldmarkemoji.Emoji))
+//	markdown.Convert([]byte("I am sad :cry: `:cry:`"), os.Stdout)
+//	//Output: <p>I am sad 😢 <code>:cry:</code></p>
+package goldmarkemoji
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/Bios-Marcel/discordemojimap/v2"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// KindEmoji is the NodeKind of the EmojiNode.
+var KindEmoji = ast.NewNodeKind("DiscordEmoji")
+
+// EmojiNode is an inline node representing either a resolved emoji code or a
+// custom emoji.
+type EmojiNode struct {
+	ast.BaseInline
+
+	// Code is the lowercased emoji code without colons. For custom emoji,
+	// this is the name of the emoji.
+	Code string
+	// Value is the unicode emoji, as found in discordemojimap.EmojiMap. It is
+	// empty for custom emoji.
+	Value string
+	// Custom is only set if IsCustom is true.
+	Custom   discordemojimap.CustomEmoji
+	IsCustom bool
+}
+
+// Kind implements ast.Node.Kind.
+func (n *EmojiNode) Kind() ast.NodeKind {
+	return KindEmoji
+}
+
+// Dump implements ast.Node.Dump.
+func (n *EmojiNode) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{
+		"Code":  n.Code,
+		"Value": n.Value,
+	}, nil)
+}
+
+type emojiParser struct{}
+
+// NewParser returns a new InlineParser that parses emoji codes and custom
+// emoji markup.
+func NewParser() parser.InlineParser {
+	return &emojiParser{}
+}
+
+func (p *emojiParser) Trigger() []byte {
+	return []byte{':', '<'}
+}
+
+func (p *emojiParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	line, _ := block.PeekLine()
+
+	if line[0] == '<' {
+		end := bytes.IndexByte(line, '>')
+		if end == -1 {
+			return nil
+		}
+		custom, err := discordemojimap.ParseCustomEmoji(string(line[:end+1]))
+		if err != nil {
+			return nil
+		}
+		block.Advance(end + 1)
+		return &EmojiNode{Code: custom.Name, Custom: custom, IsCustom: true}
+	}
+
+	// Same as discordemojimap.Replace, an unresolvable sequence doesn't
+	// consume the closing colon, as it might be the start of another one.
+	end := bytes.IndexByte(line[1:], ':') + 1
+	if end <= 1 {
+		return nil
+	}
+	code := string(line[1:end])
+	emoji := discordemojimap.GetEmoji(code)
+	if emoji == "" {
+		return nil
+	}
+	block.Advance(end + 1)
+	return &EmojiNode{Code: lowerASCII(code), Value: emoji}
+}
+
+// lowerASCII returns the code as it's stored in discordemojimap.EmojiMap.
+// GetEmoji only ignores the case of ASCII letters, so other runes are kept.
+func lowerASCII(code string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'A' && r <= 'Z' {
+			return r + 'a' - 'A'
+		}
+		return r
+	}, code)
+}
+
+// Option configures the extension.
+type Option func(*emojiRenderer)
+
+// WithImages renders emoji as <img class="emoji"> tags instead of text.
+// imageURL receives the unicode emoji and returns the src of the image. If
+// imageURL is nil, the Twemoji PNG assets are used. Custom emoji are always
+// served by Discord's CDN.
+func WithImages(imageURL func(emoji string) string) Option {
+	return func(r *emojiRenderer) {
+		r.images = true
+		r.imageURL = imageURL
+	}
+}
+
+type emojiRenderer struct {
+	images   bool
+	imageURL func(emoji string) string
+}
+
+// NewRenderer returns a new NodeRenderer that renders EmojiNode nodes.
+func NewRenderer(opts ...Option) renderer.NodeRenderer {
+	r := &emojiRenderer{}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *emojiRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(KindEmoji, r.renderEmoji)
+}
+
+func (r *emojiRenderer) renderEmoji(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	emoji := node.(*EmojiNode)
+	switch {
+	case r.images && emoji.IsCustom:
+		token := discordemojimap.Token{Kind: discordemojimap.CustomEmojiToken, Custom: emoji.Custom}
+		_, _ = w.WriteString(token.ImageTag(r.imageURL))
+	case r.images:
+		token := discordemojimap.Token{Kind: discordemojimap.CodeToken, Emoji: emoji.Value, Code: emoji.Code}
+		_, _ = w.WriteString(token.ImageTag(r.imageURL))
+	case emoji.IsCustom:
+		_, _ = w.Write(util.EscapeHTML([]byte(":" + emoji.Code + ":")))
+	default:
+		_, _ = w.WriteString(emoji.Value)
+	}
+	return ast.WalkSkipChildren, nil
+}
+
+type emojiExtension struct {
+	options []Option
+}
+
+// Emoji is an extension that renders emoji codes and custom emoji as text.
+// Custom emoji are rendered as ":name:", since they don't have a text
+// representation.
+var Emoji = New()
+
+// New returns a new extension with the given options.
+func New(opts ...Option) goldmark.Extender {
+	return &emojiExtension{options: opts}
+}
+
+// Extend implements goldmark.Extender.
+func (e *emojiExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		// Custom emoji markup has to be parsed before autolinks and raw HTML.
+		util.Prioritized(NewParser(), 250),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(NewRenderer(e.options...), 500),
+	))
+}
@@ -0,0 +1,72 @@
+package goldmarkemoji
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/yuin/goldmark"
+)
+
+func TestEmoji(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		input  string
+		want   string
+		images bool
+	}{
+		{name: "no emoji", input: "Hello :invalid:", want: "<p>Hello :invalid:</p>\n"},
+		{name: "code", input: "I am sad :CRY:", want: "<p>I am sad 😢</p>\n"},
+		{name: "non-ASCII case folding", input: ":o\u212a:", want: "<p>:o\u212a:</p>\n"},
+		{name: "unnecessary colon before code", input: "::+1:", want: "<p>:👍</p>\n"},
+		{name: "code span is left untouched", input: "`:cry:` :cry:", want: "<p><code>:cry:</code> 😢</p>\n"},
+		{name: "code block is left untouched", input: "    :cry:", want: "<pre><code>:cry:\n</code></pre>\n"},
+		{name: "emphasis", input: "*:cry:*", want: "<p><em>😢</em></p>\n"},
+		{name: "custom emoji as text", input: "<:pepe:123>", want: "<p>:pepe:</p>\n"},
+		{name: "invalid custom emoji", input: "<:pepe:abc>", want: "<p>&lt;:pepe:abc&gt;</p>\n"},
+		{name: "autolink still works", input: "<https://discord.com>", want: "<p><a href=\"https://discord.com\">https://discord.com</a></p>\n"},
+		{
+			name:   "code as image",
+			input:  ":cry:",
+			want:   "<p><img class=\"emoji\" alt=\"😢\" title=\":cry:\" src=\"https://cdn.jsdelivr.net/gh/jdecked/twemoji@15.0.3/assets/72x72/1f622.png\"></p>\n",
+			images: true,
+		},
+		{
+			name:   "uppercase code as image",
+			input:  ":CRY:",
+			want:   "<p><img class=\"emoji\" alt=\"😢\" title=\":cry:\" src=\"https://cdn.jsdelivr.net/gh/jdecked/twemoji@15.0.3/assets/72x72/1f622.png\"></p>\n",
+			images: true,
+		},
+		{
+			name:   "custom emoji as image",
+			input:  "<a:pepe:123>",
+			want:   "<p><img class=\"emoji\" alt=\":pepe:\" title=\":pepe:\" src=\"https://cdn.discordapp.com/emojis/123.gif\"></p>\n",
+			images: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			extension := Emoji
+			if tt.images {
+				extension = New(WithImages(nil))
+			}
+			var buffer bytes.Buffer
+			if err := goldmark.New(goldmark.WithExtensions(extension)).Convert([]byte(tt.input), &buffer); err != nil {
+				t.Fatal(err)
+			}
+			if got := buffer.String(); got != tt.want {
+				t.Errorf("Convert() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Example() {
+	markdown := goldmark.New(goldmark.WithExtensions(Emoji))
+	_ = markdown.Convert([]byte("I am sad :cry: `:cry:`"), os.Stdout)
+	// Output: <p>I am sad 😢 <code>:cry:</code></p>
+}
@@ -0,0 +1,8 @@
+module github.com/Bios-Marcel/discordemojimap/v2/goldmarkemoji
+
+go 1.22.0
+
+require (
+	github.com/Bios-Marcel/discordemojimap/v2 v2.0.0-20261019103724-aed90c52540f
+	github.com/yuin/goldmark v1.7.8
+)
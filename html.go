@@ -0,0 +1,75 @@
+package discordemojimap
+
+import (
+	"html"
+	"strings"
+)
+
+// ReplaceHTML returns the input as HTML-escaped text, where code sequences
+// and unicode emoji have been replaced with image tags. For example:
+//
+//	fmt.Println(ReplaceHTML("I am <sad> :cry:", nil))
+//	//Output: I am &lt;sad&gt; <img class="emoji" alt="😢" title=":cry:" src="https://cdn.jsdelivr.net/gh/jdecked/twemoji@15.0.3/assets/72x72/1f622.png">
+//
+// imageURL receives the emoji as found in EmojiMap and returns the src of the
+// image. If imageURL is nil, the Twemoji PNG assets are used. Custom emoji
+// markup is rendered as an image served by Discord's CDN.
+func ReplaceHTML(input string, imageURL func(emoji string) string) string {
+	var buffer strings.Builder
+	buffer.Grow(len(input))
+	for _, token := range Tokenize(input) {
+		if !writeImageTag(&buffer, token, imageURL) {
+			buffer.WriteString(html.EscapeString(token.Text))
+		}
+	}
+
+	return buffer.String()
+}
+
+// ImageTag returns the image tag ReplaceHTML renders for the token. imageURL
+// is used the same way as by ReplaceHTML. If the token is a TextToken, an
+// empty string is returned.
+func (t Token) ImageTag(imageURL func(emoji string) string) string {
+	var buffer strings.Builder
+	writeImageTag(&buffer, t, imageURL)
+	return buffer.String()
+}
+
+func twemojiPNGURL(emoji string) string {
+	return TwemojiURL(TwemojiPNGBaseURL, emoji, "png")
+}
+
+// writeImageTag writes the image tag for the token and returns whether the
+// token is an emoji.
+func writeImageTag(buffer *strings.Builder, token Token, imageURL func(emoji string) string) bool {
+	switch token.Kind {
+	case CodeToken, EmojiToken:
+		if imageURL == nil {
+			imageURL = twemojiPNGURL
+		}
+		writeImageElement(buffer, token.Emoji, ":"+token.Code+":", imageURL(token.Emoji))
+	case CustomEmojiToken:
+		format := PNG
+		if token.Custom.Animated {
+			format = GIF
+		}
+		// The format is always valid and the size is left out, so this
+		// can't fail.
+		src, _ := token.Custom.URL(format, 0)
+		name := ":" + token.Custom.Name + ":"
+		writeImageElement(buffer, name, name, src)
+	default:
+		return false
+	}
+	return true
+}
+
+func writeImageElement(buffer *strings.Builder, alt, title, src string) {
+	buffer.WriteString(`<img class="emoji" alt="`)
+	buffer.WriteString(html.EscapeString(alt))
+	buffer.WriteString(`" title="`)
+	buffer.WriteString(html.EscapeString(title))
+	buffer.WriteString(`" src="`)
+	buffer.WriteString(html.EscapeString(src))
+	buffer.WriteString(`">`)
+}
@@ -0,0 +1,70 @@
+package discordemojimap
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestReplaceHTML(t *testing.T) {
+	t.Parallel()
+
+	imageURL := func(emoji string) string {
+		return "/emoji/" + TwemojiFilename(emoji) + ".svg"
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "empty string",
+			input: "",
+			want:  "",
+		},
+		{
+			name:  "text is escaped",
+			input: `<b>"Tom" & 'Jerry'</b>`,
+			want:  "&lt;b&gt;&#34;Tom&#34; &amp; &#39;Jerry&#39;&lt;/b&gt;",
+		},
+		{
+			name:  "code",
+			input: "I am sad :cry:",
+			want:  `I am sad <img class="emoji" alt="😢" title=":cry:" src="/emoji/1f622.svg">`,
+		},
+		{
+			name:  "unicode emoji",
+			input: "<3 ❤️",
+			want:  `&lt;3 <img class="emoji" alt="❤️" title=":heart:" src="/emoji/2764.svg">`,
+		},
+		{
+			name:  "text presentation",
+			input: "Copyright © 2024 ™ ‼",
+			want:  "Copyright © 2024 ™ ‼",
+		},
+		{
+			name:  "zwj sequence",
+			input: "👨‍👩‍👧‍👦",
+			want:  `<img class="emoji" alt="👨‍👩‍👧‍👦" title=":family_mwgb:" src="/emoji/1f468-200d-1f469-200d-1f467-200d-1f466.svg">`,
+		},
+		{
+			name:  "custom emoji",
+			input: "<a:pepe:456>",
+			want:  `<img class="emoji" alt=":pepe:" title=":pepe:" src="https://cdn.discordapp.com/emojis/456.gif">`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := ReplaceHTML(tt.input, imageURL); got != tt.want {
+				t.Errorf("ReplaceHTML() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleReplaceHTML() {
+	fmt.Println(ReplaceHTML("I am <sad> :cry:", nil))
+	// Output: I am &lt;sad&gt; <img class="emoji" alt="😢" title=":cry:" src="https://cdn.jsdelivr.net/gh/jdecked/twemoji@15.0.3/assets/72x72/1f622.png">
+}
@@ -0,0 +1,31 @@
+package discordemojimap
+
+import "strings"
+
+// JumboEmojiLimit is the maximum amount of emoji a message may consist of
+// for Discord to render them as large emoji.
+const JumboEmojiLimit = 30
+
+// IsEmojiOnly reports whether Discord renders the message with large emoji.
+// This is the case if the message consists of at least one and at most
+// JumboEmojiLimit emoji and whitespace. Unicode emoji, custom emoji and codes
+// that resolve to an emoji are counted. For example:
+//
+//	fmt.Println(IsEmojiOnly(":cry: 😢 <:pepe:123>"))
+//	//Output: true 3
+//
+// The amount of emoji in the message is always returned, even if the
+// message contains other text or too many emoji.
+func IsEmojiOnly(input string) (bool, int) {
+	emojiOnly := true
+	var count int
+	for _, token := range Tokenize(input) {
+		if token.Kind != TextToken {
+			count++
+		} else if strings.TrimSpace(token.Text) != "" {
+			emojiOnly = false
+		}
+	}
+
+	return emojiOnly && count > 0 && count <= JumboEmojiLimit, count
+}
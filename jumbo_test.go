@@ -0,0 +1,49 @@
+package discordemojimap
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestIsEmojiOnly(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     string
+		want      bool
+		wantCount int
+	}{
+		{name: "empty string", input: "", want: false, wantCount: 0},
+		{name: "whitespace", input: " \n ", want: false, wantCount: 0},
+		{name: "text", input: "Hello", want: false, wantCount: 0},
+		{name: "text with emoji", input: "Hello 😢", want: false, wantCount: 1},
+		{name: "unknown code", input: "😢 :invalidinvalid:", want: false, wantCount: 1},
+		{name: "single emoji", input: "😢", want: true, wantCount: 1},
+		{name: "text presentation", input: "©", want: false, wantCount: 0},
+		{name: "emoji presentation", input: "©\ufe0f", want: true, wantCount: 1},
+		{name: "whitespace around emoji", input: "\t😢 \n", want: true, wantCount: 1},
+		{name: "code", input: ":cry:", want: true, wantCount: 1},
+		{name: "custom emoji", input: "<a:pepe:123>", want: true, wantCount: 1},
+		{name: "zwj sequence with skin tone", input: "👩🏽‍💻", want: true, wantCount: 1},
+		{name: "mixed", input: ":cry: 😢<:pepe:123>🇩🇪", want: true, wantCount: 4},
+		{name: "limit", input: strings.Repeat("😢", JumboEmojiLimit), want: true, wantCount: JumboEmojiLimit},
+		{name: "above limit", input: strings.Repeat(":cry:", JumboEmojiLimit+1), want: false, wantCount: JumboEmojiLimit + 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, count := IsEmojiOnly(tt.input)
+			if got != tt.want || count != tt.wantCount {
+				t.Errorf("IsEmojiOnly(%q) = %v, %d, want %v, %d", tt.input, got, count, tt.want, tt.wantCount)
+			}
+		})
+	}
+}
+
+func ExampleIsEmojiOnly() {
+	fmt.Println(IsEmojiOnly(":cry: 😢 <:pepe:123>"))
+	// Output: true 3
+}
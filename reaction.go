@@ -0,0 +1,53 @@
+package discordemojimap
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// ErrUnknownEmoji is returned if the input is neither a known emoji, a known
+// code nor a custom emoji.
+var ErrUnknownEmoji = errors.New("unknown emoji")
+
+// EncodeReaction converts user input into the form required by Discord's
+// reaction endpoints. For example:
+//
+//	fmt.Println(EncodeReaction(":cry:"))
+//	//Output: %F0%9F%98%A2 <nil>
+//
+// The input may be a code with or without colons, a unicode emoji, custom
+// emoji markup such as "<:name:id>" or "<a:name:id>", or a custom emoji in
+// the form of "name:id". Unicode emoji are URL-escaped, while custom emoji
+// are returned as "name:id". Surrounding whitespace is ignored and codes are
+// searched case-insensitive.
+//
+// Unicode emoji are normalised to the form Discord knows, so a missing
+// variation selector, such as in "❤" instead of "❤️", isn't an error.
+func EncodeReaction(input string) (string, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return "", ErrUnknownEmoji
+	}
+
+	if custom, err := ParseCustomEmoji(input); err == nil {
+		return custom.Name + ":" + custom.ID, nil
+	}
+	if custom, length := parseCustomEmoji("<:" + input + ">"); length == len(input)+3 {
+		return custom.Name + ":" + custom.ID, nil
+	}
+
+	if emoji, exists := emojiIndexLookup(input); exists {
+		return url.PathEscape(emoji), nil
+	}
+
+	code := input
+	if len(code) > 2 && code[0] == ':' && code[len(code)-1] == ':' {
+		code = code[1 : len(code)-1]
+	}
+	if emoji := GetEmoji(code); emoji != "" {
+		return url.PathEscape(emoji), nil
+	}
+
+	return "", ErrUnknownEmoji
+}
@@ -0,0 +1,59 @@
+package discordemojimap
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestEncodeReaction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr error
+	}{
+		{name: "empty string", input: "", wantErr: ErrUnknownEmoji},
+		{name: "whitespace", input: "  ", wantErr: ErrUnknownEmoji},
+		{name: "unknown code", input: ":invalidinvalid:", wantErr: ErrUnknownEmoji},
+		{name: "unknown code without colons", input: "invalidinvalid", wantErr: ErrUnknownEmoji},
+		{name: "colons only", input: "::", wantErr: ErrUnknownEmoji},
+		{name: "text with emoji", input: "😢 sad", wantErr: ErrUnknownEmoji},
+		{name: "two emoji", input: "😢😢", wantErr: ErrUnknownEmoji},
+		{name: "custom emoji with invalid id", input: "pepe:12a", wantErr: ErrUnknownEmoji},
+		{name: "code", input: ":cry:", want: "%F0%9F%98%A2"},
+		{name: "fully-qualified sequence", input: "\U0001f441\ufe0f\u200d\U0001f5e8\ufe0f", want: "%F0%9F%91%81%E2%80%8D%F0%9F%97%A8"},
+		{name: "uppercase code", input: ":CRY:", want: "%F0%9F%98%A2"},
+		{name: "code without colons", input: "cry", want: "%F0%9F%98%A2"},
+		{name: "code with whitespace", input: " :cry: ", want: "%F0%9F%98%A2"},
+		{name: "unicode emoji", input: "😢", want: "%F0%9F%98%A2"},
+		{name: "unicode emoji with skin tone", input: "👍🏻", want: "%F0%9F%91%8D%F0%9F%8F%BB"},
+		{name: "missing variation selector", input: "❤", want: "%E2%9D%A4%EF%B8%8F"},
+		{name: "keycap", input: "1️⃣", want: "1%EF%B8%8F%E2%83%A3"},
+		{name: "custom emoji markup", input: "<:pepe:123>", want: "pepe:123"},
+		{name: "animated custom emoji markup", input: "<a:pepe:123>", want: "pepe:123"},
+		{name: "custom emoji", input: "pepe:123", want: "pepe:123"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := EncodeReaction(tt.input)
+			if err != tt.wantErr {
+				t.Fatalf("EncodeReaction(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("EncodeReaction(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleEncodeReaction() {
+	fmt.Println(EncodeReaction(":cry:"))
+	fmt.Println(EncodeReaction("<:pepe:123>"))
+	// Output:
+	// %F0%9F%98%A2 <nil>
+	// pepe:123 <nil>
+}
@@ -2,6 +2,8 @@
 // emoji sequences with their respective emojis.
 package discordemojimap
 
+import "unicode/utf8"
+
 // Replace all emoji sequences contained in the emoji map with their
 // respective emojis. For example:
 //
@@ -104,6 +106,141 @@ func Replace(input string) string {
 	return input
 }
 
+// Replacer replaces emoji codes with their respective emoji, just like
+// Replace. However, it can be restricted to only produce certain emoji. The
+// zero value replaces all codes.
+//
+// Unlike Replace, the Replacer leaves custom emoji markup such as
+// "<:cry:123>" untouched.
+type Replacer struct {
+	// MaxUnicodeVersion is the newest emoji version that may be emitted. This
+	// is useful for terminals that can't display newer emoji. For example,
+	// with a MaxUnicodeVersion of 13.1, ":playground_slide:" is left as is,
+	// since "🛝" was added in emoji version 14.0. 0 means no restriction.
+	MaxUnicodeVersion float64
+	// NormalizeSkinTones replaces emoji with skin tones with the emoji
+	// without skin tones. For example ":thumbsup_tone1:" is replaced with
+	// "👍" and ReplaceEmoji replaces "👍🏻" with "👍" or ":thumbsup:".
+	NormalizeSkinTones bool
+	// Allow restricts the Replacer to emoji matching the filter. An empty
+	// filter allows all emoji.
+	Allow Filter
+	// Deny prevents the Replacer from emitting emoji matching the filter,
+	// even if they are allowed by Allow. For example, a Deny filter with
+	// CategoryFlags leaves ":flag_de:" as is.
+	Deny Filter
+	// RemoveFiltered removes emoji rejected by Allow or Deny, instead of
+	// leaving them as text. Replace removes their codes and ReplaceEmoji
+	// removes the emoji.
+	RemoveFiltered bool
+}
+
+// Replace all emoji codes that the Replacer may emit with their respective
+// emoji. All other codes are left as they are.
+func (r Replacer) Replace(input string) string {
+	tokens := Tokenize(input)
+	buffer := make([]byte, 0, len(input))
+	for _, token := range tokens {
+		emoji := token.Emoji
+		if r.NormalizeSkinTones {
+			emoji = baseEmoji(emoji)
+		}
+		switch {
+		case token.Kind != CodeToken:
+			buffer = append(buffer, token.Text...)
+		case r.filtered(emoji):
+			if !r.RemoveFiltered {
+				buffer = append(buffer, token.Text...)
+			}
+		case r.allowed(emoji):
+			buffer = append(buffer, emoji...)
+		default:
+			buffer = append(buffer, token.Text...)
+		}
+	}
+	return string(buffer)
+}
+
+// ReplaceEmoji replaces all unicode emoji that the Replacer may not emit
+// with their primary code. This allows degrading text that already contains
+// emoji. Emoji rejected by Allow or Deny are replaced with their code as
+// well, or removed if RemoveFiltered is set. For example, with a
+// MaxUnicodeVersion of 13.1:
+//
+//	fmt.Println(Replacer{MaxUnicodeVersion: 13.1}.ReplaceEmoji("😀 🛝"))
+//	//Output: 😀 :playground_slide:
+func (r Replacer) ReplaceEmoji(input string) string {
+	var shouldRemove func(emoji string) bool
+	if r.RemoveFiltered {
+		shouldRemove = r.filtered
+	}
+	return replaceEmoji(input, r.NormalizeSkinTones, func(emoji string) bool {
+		return r.filtered(emoji) || !r.allowed(emoji)
+	}, shouldRemove)
+}
+
+// ReplaceEmoji replaces all unicode emoji with their primary code, reversing
+// Replace. For example:
+//
+//	fmt.Println(ReplaceEmoji("Hello World 🌞"))
+//	//Output: Hello World :sun_with_face:
+func ReplaceEmoji(input string) string {
+	return replaceEmoji(input, false, func(string) bool {
+		return true
+	}, nil)
+}
+
+// replaceEmoji replaces the emoji for which shouldReplace returns true with
+// their primary code. If shouldRemove is set and returns true, the emoji is
+// removed instead.
+func replaceEmoji(input string, normalizeSkinTones bool, shouldReplace, shouldRemove func(emoji string) bool) string {
+	// Nothing to do for pure ASCII, as all emoji consist of multi-byte
+	// sequences.
+	index := 0
+	for ; index < len(input) && input[index] < utf8.RuneSelf; index++ {
+	}
+	if index == len(input) {
+		return input
+	}
+
+	tokens := Tokenize(input)
+	buffer := make([]byte, 0, len(input))
+	for _, token := range tokens {
+		if token.Kind != EmojiToken {
+			buffer = append(buffer, token.Text...)
+			continue
+		}
+
+		emoji, code := token.Emoji, token.Code
+		if normalizeSkinTones {
+			emoji = baseEmoji(emoji)
+			code = codesByEmoji[emoji][0]
+		}
+		if shouldRemove != nil && shouldRemove(emoji) {
+			continue
+		}
+		if shouldReplace(emoji) {
+			buffer = append(buffer, ':')
+			buffer = append(buffer, code...)
+			buffer = append(buffer, ':')
+		} else if emoji != token.Emoji {
+			buffer = append(buffer, emoji...)
+		} else {
+			buffer = append(buffer, token.Text...)
+		}
+	}
+	return string(buffer)
+}
+
+func (r Replacer) allowed(emoji string) bool {
+	return r.MaxUnicodeVersion == 0 || UnicodeVersion(emoji) <= r.MaxUnicodeVersion
+}
+
+// filtered returns true if the emoji is rejected by Allow or Deny.
+func (r Replacer) filtered(emoji string) bool {
+	return (!r.Allow.IsEmpty() && !r.Allow.Matches(emoji)) || r.Deny.Matches(emoji)
+}
+
 // toLower is an optimised variant of strings.ToLower. It only works for ASCII
 // and returns an empty string if nothing has changed, this reduces return
 // parameters, which in turn improves performance. It also avoids allocations
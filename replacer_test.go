@@ -1,6 +1,7 @@
 package discordemojimap
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 	"testing"
@@ -152,3 +153,177 @@ func Test_toLower(t *testing.T) {
 		}
 	}
 }
+
+func TestReplacerReplace(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		replacer Replacer
+		input    string
+		want     string
+	}{
+		{name: "zero value", input: "I am :cry: :playground_slide:", want: "I am 😢 🛝"},
+		{name: "custom emoji are left untouched", input: "<:cry:123>", want: "<:cry:123>"},
+		{
+			name:     "newer emoji are left as code",
+			replacer: Replacer{MaxUnicodeVersion: 13.1},
+			input:    "I am :CRY: :playground_slide:",
+			want:     "I am 😢 :playground_slide:",
+		},
+		{
+			name:     "newer zwj sequences are left as code",
+			replacer: Replacer{MaxUnicodeVersion: 12},
+			input:    ":face_in_clouds: :heart_on_fire:",
+			want:     ":face_in_clouds: :heart_on_fire:",
+		},
+		{
+			name:     "version is inclusive",
+			replacer: Replacer{MaxUnicodeVersion: 14.0},
+			input:    ":playground_slide:",
+			want:     "🛝",
+		},
+		{
+			name:     "normalized skin tones",
+			replacer: Replacer{NormalizeSkinTones: true},
+			input:    ":thumbsup_tone1: :handshake_tone1_tone5: :cry:",
+			want:     "👍 🤝 😢",
+		},
+		{
+			name:     "denied category is left as code",
+			replacer: Replacer{Deny: Filter{Categories: []Category{CategoryFlags}}},
+			input:    ":flag_de: :cry:",
+			want:     ":flag_de: 😢",
+		},
+		{
+			name:     "denied code matches aliases and skin tones",
+			replacer: Replacer{Deny: Filter{Codes: []string{"THUMBSUP"}}},
+			input:    ":+1: :thumbsup_tone3: :cry:",
+			want:     ":+1: :thumbsup_tone3: 😢",
+		},
+		{
+			name:     "denied emoji are removed",
+			replacer: Replacer{Deny: Filter{Emoji: []string{"😢"}}, RemoveFiltered: true},
+			input:    "I am :cry: :smile:",
+			want:     "I am  😄",
+		},
+		{
+			name:     "only allowed emoji are replaced",
+			replacer: Replacer{Allow: Filter{Codes: []string{"smile"}, Categories: []Category{CategoryFood}}},
+			input:    ":cry: :smile: :green_apple:",
+			want:     ":cry: 😄 🍏",
+		},
+		{
+			name: "deny takes precedence over allow",
+			replacer: Replacer{
+				Allow: Filter{Categories: []Category{CategoryPeople}},
+				Deny:  Filter{Codes: []string{"cry"}},
+			},
+			input: ":cry: :smile: :dog:",
+			want:  ":cry: 😄 :dog:",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.replacer.Replace(tt.input); got != tt.want {
+				t.Errorf("Replace() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReplacerReplaceEmoji(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		replacer Replacer
+		input    string
+		want     string
+	}{
+		{name: "zero value keeps all emoji", input: "😢 🛝", want: "😢 🛝"},
+		{
+			name:     "newer emoji are replaced with code",
+			replacer: Replacer{MaxUnicodeVersion: 13.1},
+			input:    "😢 🛝 :cry:",
+			want:     "😢 :playground_slide: :cry:",
+		},
+		{
+			name:     "normalized skin tones",
+			replacer: Replacer{NormalizeSkinTones: true},
+			input:    "👍🏻 🫱🏻‍🫲🏿 😢",
+			want:     "👍 🤝 😢",
+		},
+		{
+			name:     "normalized skin tones with version limit",
+			replacer: Replacer{NormalizeSkinTones: true, MaxUnicodeVersion: 1.0},
+			input:    "👍🏻 🫱🏻‍🫲🏿",
+			want:     "👍 :handshake:",
+		},
+		{
+			name:     "zwj sequences use primary code",
+			replacer: Replacer{MaxUnicodeVersion: 2.0},
+			input:    "🏳️‍🌈",
+			want:     ":rainbow_flag:",
+		},
+		{
+			name:     "zwj sequences use their own version",
+			replacer: Replacer{MaxUnicodeVersion: 12},
+			input:    "😶‍🌫️ ❤️‍🔥 🏳️‍🌈",
+			want:     ":face_in_clouds: :heart_on_fire: 🏳️‍🌈",
+		},
+		{
+			name:     "denied emoji are replaced with code",
+			replacer: Replacer{Deny: Filter{Categories: []Category{CategoryFlags}}},
+			input:    "🇩🇪 😢",
+			want:     ":flag_de: 😢",
+		},
+		{
+			name:     "denied emoji are removed",
+			replacer: Replacer{Deny: Filter{Emoji: []string{"👍"}}, RemoveFiltered: true},
+			input:    "👍🏽 😢",
+			want:     " 😢",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.replacer.ReplaceEmoji(tt.input); got != tt.want {
+				t.Errorf("ReplaceEmoji() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReplaceEmoji(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct{ name, input, want string }{
+		{"empty string", "", ""},
+		{"no emoji", "Hello :cry:", "Hello :cry:"},
+		{"single emoji", "I am sad 😢", "I am sad :cry:"},
+		{"primary code", "👍👍🏻", ":thumbsup::thumbsup_tone1:"},
+		{"text presentation", "I ❤ Go", "I ❤ Go"},
+		{"variation selector", "I ❤️ Go", "I :heart: Go"},
+		{"copyright, registered and trademark signs", "© ® ™", "© ® ™"},
+		{"custom emoji", "<:pepe:123>", "<:pepe:123>"},
+	}
+	for _, tt := range tests {
+		if got := ReplaceEmoji(tt.input); got != tt.want {
+			t.Errorf("%s: ReplaceEmoji() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func ExampleReplaceEmoji() {
+	fmt.Println(ReplaceEmoji("Hello World 🌞"))
+	// Output: Hello World :sun_with_face:
+}
+
+func ExampleReplacer_ReplaceEmoji() {
+	fmt.Println(Replacer{MaxUnicodeVersion: 13.1}.ReplaceEmoji("😀 🛝"))
+	// Output: 😀 :playground_slide:
+}
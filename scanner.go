@@ -0,0 +1,213 @@
+package discordemojimap
+
+import (
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// TokenKind describes what part of a message a Token represents.
+type TokenKind uint8
+
+const (
+	// TextToken is text that doesn't contain any emoji.
+	TextToken TokenKind = iota
+	// CodeToken is a code sequence such as ":cry:" that resolves to an emoji.
+	CodeToken
+	// EmojiToken is a unicode emoji such as "😢".
+	EmojiToken
+	// CustomEmojiToken is the markup of a custom emoji such as "<:pepe:123>".
+	CustomEmojiToken
+)
+
+// Token is a part of a message, as returned by Tokenize.
+type Token struct {
+	Kind TokenKind
+	// Text is the part of the input the token was created from.
+	Text string
+	// Emoji is the unicode emoji, as found in EmojiMap. It is only set for
+	// CodeToken and EmojiToken.
+	Emoji string
+	// Code is the lowercased code without colons for CodeToken and the
+	// primary code of the emoji for EmojiToken.
+	Code string
+	// Custom is only set for CustomEmojiToken.
+	Custom CustomEmoji
+}
+
+// Tokenize splits the input into text, code sequences, unicode emoji and
+// custom emoji. Concatenating the Text of all tokens results in the input.
+//
+// Code sequences are resolved the same way Replace resolves them. However,
+// custom emoji markup takes precedence, so "<:cry:123>" is a custom emoji and
+// not a code sequence. Unicode emoji are matched greedily, meaning that "👍🏻"
+// is a single token, instead of a thumbs up followed by a skin tone. Sequences
+// with more or fewer variation selectors than in EmojiMap, such as "❤‍🔥"
+// instead of "❤️‍🔥", are recognised as well. Single characters that default to text presentation,
+// such as "©" or "❤", are only emoji if followed by the variation selector
+// U+FE0F, otherwise they're text.
+func Tokenize(input string) []Token {
+	var tokens []Token
+	var lastEnd int
+	for index := 0; index < len(input); index++ {
+		if input[index] != '<' {
+			continue
+		}
+
+		emoji, length := parseCustomEmoji(input[index:])
+		if length == 0 {
+			continue
+		}
+
+		tokens = tokenizeCodes(tokens, input[lastEnd:index])
+		tokens = append(tokens, Token{
+			Kind:   CustomEmojiToken,
+			Text:   input[index : index+length],
+			Custom: emoji,
+		})
+		lastEnd = index + length
+		index = lastEnd - 1
+	}
+
+	return tokenizeCodes(tokens, input[lastEnd:])
+}
+
+// tokenizeCodes resolves code sequences in the input, following the same
+// rules as Replace. The text inbetween is passed on to tokenizeEmoji.
+func tokenizeCodes(tokens []Token, input string) []Token {
+	start := -1
+	var lastEnd int
+	for index := 0; index < len(input); index++ {
+		if input[index] != ':' {
+			continue
+		}
+
+		if start == -1 || index-start == 1 {
+			start = index
+			continue
+		}
+
+		code := input[start+1 : index]
+		if lowered := toLower(code); lowered != "" {
+			code = lowered
+		}
+		emoji := EmojiMap[code]
+		if emoji == "" {
+			start = -1
+			index--
+			continue
+		}
+
+		tokens = tokenizeEmoji(tokens, input[lastEnd:start])
+		tokens = append(tokens, Token{
+			Kind:  CodeToken,
+			Text:  input[start : index+1],
+			Emoji: emoji,
+			Code:  code,
+		})
+		lastEnd = index + 1
+		start = -1
+	}
+
+	return tokenizeEmoji(tokens, input[lastEnd:])
+}
+
+// tokenizeEmoji finds unicode emoji in the input. All remaining text is
+// added as TextToken.
+func tokenizeEmoji(tokens []Token, input string) []Token {
+	var lastEnd int
+	for index := 0; index < len(input); {
+		emoji, length := matchEmoji(input[index:])
+		if length == 0 {
+			_, size := utf8.DecodeRuneInString(input[index:])
+			index += size
+			continue
+		}
+
+		if lastEnd < index {
+			tokens = append(tokens, Token{Kind: TextToken, Text: input[lastEnd:index]})
+		}
+		tokens = append(tokens, Token{
+			Kind:  EmojiToken,
+			Text:  input[index : index+length],
+			Emoji: emoji,
+			Code:  codesByEmoji[emoji][0],
+		})
+		index += length
+		lastEnd = index
+	}
+
+	if lastEnd < len(input) {
+		tokens = append(tokens, Token{Kind: TextToken, Text: input[lastEnd:]})
+	}
+	return tokens
+}
+
+var (
+	emojiIndexOnce sync.Once
+	// emojiIndex maps all emoji without variation selectors to the emoji as
+	// found in EmojiMap. This way, emoji are found no matter whether they
+	// have more or fewer selectors than Discord's form, for example the
+	// fully-qualified "👁️‍🗨️", which Discord stores as "👁‍🗨".
+	emojiIndex map[string]string
+	// emojiIndexMaxLength is the length of the longest key in emojiIndex.
+	emojiIndexMaxLength int
+)
+
+func buildEmojiIndex() {
+	emojiIndex = make(map[string]string, len(codesByEmoji))
+	for emoji := range codesByEmoji {
+		stripped := strings.ReplaceAll(emoji, string(variationSelector), "")
+		emojiIndexMaxLength = max(emojiIndexMaxLength, len(stripped))
+		// Emoji stored without selectors win. Otherwise, we pick the
+		// smaller emoji, so the result doesn't depend on map iteration
+		// order.
+		if current, exists := emojiIndex[stripped]; !exists || (current != stripped && (emoji == stripped || emoji < current)) {
+			emojiIndex[stripped] = emoji
+		}
+	}
+}
+
+// matchEmoji returns the longest emoji at the start of the input and the
+// amount of bytes it occupies. If there's no emoji at the start of the input,
+// 0 is returned.
+func matchEmoji(input string) (string, int) {
+	// ASCII characters are only the start of an emoji for keycaps, which are
+	// followed by a multi-byte sequence.
+	if len(input) == 0 || (input[0] < 0x80 && (len(input) == 1 || input[1] < 0x80)) {
+		return "", 0
+	}
+
+	emojiIndexOnce.Do(buildEmojiIndex)
+	var match string
+	var matchLength int
+	// stripped is the input up to index without variation selectors.
+	stripped := make([]byte, 0, emojiIndexMaxLength)
+	hasSelector := false
+	for index := 0; index < len(input); {
+		r, size := utf8.DecodeRuneInString(input[index:])
+		if r == variationSelector {
+			hasSelector = true
+		} else {
+			stripped = append(stripped, input[index:index+size]...)
+		}
+		index += size
+		if len(stripped) > emojiIndexMaxLength {
+			break
+		}
+
+		emoji, ok := emojiIndex[string(stripped)]
+		if !ok {
+			continue
+		}
+		// Emoji with emoji presentation by default are listed without
+		// variation selector in EmojiMap. Therefore, a single character
+		// that only matched without its selector defaults to text
+		// presentation, such as "©", and isn't an emoji.
+		if !hasSelector && emoji != input[:index] && utf8.RuneCount(stripped) == 1 {
+			continue
+		}
+		match, matchLength = emoji, index
+	}
+	return match, matchLength
+}
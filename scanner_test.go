@@ -0,0 +1,167 @@
+package discordemojimap
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  []Token
+	}{
+		{
+			name:  "empty string",
+			input: "",
+		},
+		{
+			name:  "text only",
+			input: "Hello :invalid:",
+			want:  []Token{{Kind: TextToken, Text: "Hello :invalid:"}},
+		},
+		{
+			name:  "code",
+			input: "I am :CRY:",
+			want: []Token{
+				{Kind: TextToken, Text: "I am "},
+				{Kind: CodeToken, Text: ":CRY:", Emoji: "😢", Code: "cry"},
+			},
+		},
+		{
+			name:  "unicode emoji uses primary code",
+			input: "👍 ok",
+			want: []Token{
+				{Kind: EmojiToken, Text: "👍", Emoji: "👍", Code: "thumbsup"},
+				{Kind: TextToken, Text: " ok"},
+			},
+		},
+		{
+			name:  "skin tone is part of the emoji",
+			input: "👍🏻👍",
+			want: []Token{
+				{Kind: EmojiToken, Text: "👍🏻", Emoji: "👍🏻", Code: "thumbsup_tone1"},
+				{Kind: EmojiToken, Text: "👍", Emoji: "👍", Code: "thumbsup"},
+			},
+		},
+		{
+			name:  "zwj sequence",
+			input: "a🏳️‍🌈b",
+			want: []Token{
+				{Kind: TextToken, Text: "a"},
+				{Kind: EmojiToken, Text: "🏳️‍🌈", Emoji: "🏳️‍🌈", Code: "rainbow_flag"},
+				{Kind: TextToken, Text: "b"},
+			},
+		},
+		{
+			name:  "text presentation without variation selector",
+			input: "❤",
+			want: []Token{
+				{Kind: TextToken, Text: "❤"},
+			},
+		},
+		{
+			name:  "text presentation with variation selector",
+			input: "❤️",
+			want: []Token{
+				{Kind: EmojiToken, Text: "❤️", Emoji: "❤️", Code: "heart"},
+			},
+		},
+		{
+			name:  "copyright, registered and trademark signs",
+			input: "© ® ™",
+			want: []Token{
+				{Kind: TextToken, Text: "© ® ™"},
+			},
+		},
+		{
+			name:  "fully-qualified sequence stored without variation selectors",
+			input: "a\U0001f441\ufe0f\u200d\U0001f5e8\ufe0f",
+			want: []Token{
+				{Kind: TextToken, Text: "a"},
+				{Kind: EmojiToken, Text: "\U0001f441\ufe0f\u200d\U0001f5e8\ufe0f", Emoji: "\U0001f441\u200d\U0001f5e8", Code: "eye_in_speech_bubble"},
+			},
+		},
+		{
+			name:  "sequence missing variation selector",
+			input: "❤\u200d🔥",
+			want: []Token{
+				{Kind: EmojiToken, Text: "❤\u200d🔥", Emoji: "❤️\u200d🔥", Code: "heart_on_fire"},
+			},
+		},
+		{
+			name:  "keycap",
+			input: "1️⃣1",
+			want: []Token{
+				{Kind: EmojiToken, Text: "1️⃣", Emoji: "1️⃣", Code: "one"},
+				{Kind: TextToken, Text: "1"},
+			},
+		},
+		{
+			name:  "custom emoji takes precedence over codes",
+			input: "<:cry:123>:cry:<a:pepe:456>",
+			want: []Token{
+				{Kind: CustomEmojiToken, Text: "<:cry:123>", Custom: CustomEmoji{Name: "cry", ID: "123"}},
+				{Kind: CodeToken, Text: ":cry:", Emoji: "😢", Code: "cry"},
+				{Kind: CustomEmojiToken, Text: "<a:pepe:456>", Custom: CustomEmoji{Name: "pepe", ID: "456", Animated: true}},
+			},
+		},
+		{
+			name:  "invalid custom emoji",
+			input: "<:cry:abc>",
+			want: []Token{
+				{Kind: TextToken, Text: "<"},
+				{Kind: CodeToken, Text: ":cry:", Emoji: "😢", Code: "cry"},
+				{Kind: TextToken, Text: "abc>"},
+			},
+		},
+		{
+			name:  "non emoji unicode",
+			input: "Grüße 世界",
+			want:  []Token{{Kind: TextToken, Text: "Grüße 世界"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Tokenize(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Tokenize() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenizeResolvesCodesLikeReplace(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range inputVariations {
+		var builder strings.Builder
+		for _, token := range Tokenize(test[1]) {
+			if token.Kind == CodeToken {
+				builder.WriteString(token.Emoji)
+			} else {
+				builder.WriteString(token.Text)
+			}
+		}
+
+		if got, want := builder.String(), Replace(test[1]); got != want {
+			t.Errorf("%s: Tokenize() = %q, Replace() = %q", test[0], got, want)
+		}
+	}
+}
+
+func ExampleTokenize() {
+	for _, token := range Tokenize("Hi :wave: 👍🏻") {
+		fmt.Printf("%d %q %q\n", token.Kind, token.Text, token.Code)
+	}
+	// Output:
+	// 0 "Hi " ""
+	// 1 ":wave:" "wave"
+	// 0 " " ""
+	// 2 "👍🏻" "thumbsup_tone1"
+}
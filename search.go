@@ -0,0 +1,112 @@
+package discordemojimap
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Search returns all emoji matching the query, with the most relevant emoji
+// first. For example, searching for "thumbs" returns "👍" and "👎".
+//
+// Emoji are matched by their CLDR name and their codes. The names are only
+// part of mapping.go if it has been generated with Unicode's emoji-test.txt,
+// see the README. Every word of the query has to match. Exact matches are
+// ranked higher than matches at the start of a term, which in turn are
+// ranked higher than matches of a single word within a term. The search is
+// case-insensitive.
+//
+// Emoji with skin tones aren't returned, use the emoji without skin tones
+// instead. If no emoji matches, nil is returned.
+func Search(query string) []string {
+	words := strings.Fields(strings.ToLower(query))
+	if len(words) == 0 {
+		return nil
+	}
+
+	type result struct {
+		emoji string
+		// score is the sum of the best match of every word.
+		score int
+		// matches is the amount of matching terms, breaking ties between
+		// emoji with the same score.
+		matches int
+	}
+	var results []result
+	for emoji, codes := range codesByEmoji {
+		if _, toned := skinToneBases[emoji]; toned {
+			continue
+		}
+
+		terms := make([]string, 0, len(codes)+1)
+		if name, exists := cldrNames[emoji]; exists {
+			terms = append(terms, name)
+		}
+		for _, code := range codes {
+			terms = append(terms, strings.ReplaceAll(code, "_", " "))
+		}
+
+		candidate := result{emoji: emoji}
+		for _, word := range words {
+			best := 0
+			for _, term := range terms {
+				if score := termScore(strings.ToLower(term), word); score > 0 {
+					best = max(best, score)
+					candidate.matches++
+				}
+			}
+			if best == 0 {
+				candidate.score = 0
+				break
+			}
+			candidate.score += best
+		}
+		if candidate.score > 0 {
+			results = append(results, candidate)
+		}
+	}
+
+	sort.Slice(results, func(a, b int) bool {
+		if results[a].score != results[b].score {
+			return results[a].score > results[b].score
+		}
+		if results[a].matches != results[b].matches {
+			return results[a].matches > results[b].matches
+		}
+		return results[a].emoji < results[b].emoji
+	})
+
+	if len(results) == 0 {
+		return nil
+	}
+	emoji := make([]string, len(results))
+	for index, result := range results {
+		emoji[index] = result.emoji
+	}
+	return emoji
+}
+
+// termScore returns how well a single lowercase word of the query matches a
+// term. 0 means no match.
+func termScore(term, word string) int {
+	switch {
+	case term == word:
+		return 4
+	case strings.HasPrefix(term, word):
+		return 3
+	}
+
+	score := 0
+	termWords := strings.FieldsFunc(term, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	for _, termWord := range termWords {
+		if termWord == word {
+			return 2
+		}
+		if strings.HasPrefix(termWord, word) {
+			score = 1
+		}
+	}
+	return score
+}
@@ -0,0 +1,50 @@
+package discordemojimap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		query string
+		// want are the first results, further matches are ignored.
+		want []string
+	}{
+		{name: "empty", query: " ", want: nil},
+		{name: "no match", query: "qwertzuiop", want: nil},
+		{name: "more matching terms rank higher", query: "crying", want: []string{"😿", "😢", "😭"}},
+		{name: "case-insensitive", query: "SOB", want: []string{"😭"}},
+		{name: "all words have to match", query: "face with tears", want: []string{"😂"}},
+		{name: "code", query: "thumbsup", want: []string{"👍"}},
+		{name: "name prefix", query: "crying ca", want: []string{"😿"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Search(tt.query)
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			if assert.GreaterOrEqual(t, len(got), len(tt.want)) {
+				assert.Equal(t, tt.want, got[:len(tt.want)])
+			}
+		})
+	}
+}
+
+func TestSearchSkipsSkinTones(t *testing.T) {
+	t.Parallel()
+
+	for _, emoji := range Search("thumbs") {
+		if _, toned := skinToneBases[emoji]; toned {
+			t.Errorf("Search returned emoji with skin tone %q", emoji)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package discordemojimap
+
+import "strings"
+
+const (
+	keycap       = '\u20e3'
+	skinToneBase = '\U0001f3fa'
+	tagSpace     = '\U000e0020'
+)
+
+// Component is a single part of an emoji sequence. Emoji such as "👩🏽‍💻"
+// consist of several components, which are joined with a zero width joiner.
+type Component struct {
+	// Emoji is the base emoji of the component, without variation selector,
+	// skin tone, keycap or tags. For example "👩" or "1" in "1️⃣". For flags
+	// this is the pair of regional indicators, such as "🇩🇪".
+	Emoji string
+	// Codes are the codes of the base emoji, with the primary code first.
+	// Codes is empty if Discord doesn't know the base emoji on its own, as
+	// is the case for the digits used in keycaps.
+	Codes []string
+	// VariationSelector is true if the base emoji is followed by the
+	// variation selector U+FE0F, requesting emoji presentation.
+	VariationSelector bool
+	// SkinTone is the skin tone modifier from 1 (light) to 5 (dark), same as
+	// the "_tone1" to "_tone5" suffixes of Discord's codes. 0 means no skin
+	// tone.
+	SkinTone int
+	// Keycap is true if the component is enclosed by the combining keycap
+	// U+20E3.
+	Keycap bool
+	// Tags contains the tag characters of subdivision flags as ASCII, for
+	// example "gbeng" for the flag of England.
+	Tags string
+}
+
+// Decompose splits an emoji into its components. For example, "👩🏽‍💻" is split
+// into "👩" with a medium skin tone and "💻". Decomposing an emoji and
+// composing the components again results in the original emoji.
+func Decompose(emoji string) []Component {
+	if emoji == "" {
+		return nil
+	}
+
+	parts := strings.Split(emoji, string(zeroWidthJoiner))
+	components := make([]Component, 0, len(parts))
+	for _, part := range parts {
+		var component Component
+		var base strings.Builder
+		var tags strings.Builder
+		for index, r := range part {
+			switch {
+			case r == variationSelector:
+				component.VariationSelector = true
+			case r > skinToneBase && r <= skinToneBase+5 && index > 0:
+				component.SkinTone = int(r - skinToneBase)
+			case r == keycap:
+				component.Keycap = true
+			case r >= tagSpace && r < cancelTag:
+				tags.WriteRune(r - tagSpace + ' ')
+			case r == cancelTag:
+			default:
+				base.WriteRune(r)
+			}
+		}
+
+		component.Emoji = base.String()
+		component.Tags = tags.String()
+		if canonical, exists := emojiIndexLookup(component.Emoji); exists {
+			component.Codes = append([]string(nil), codesByEmoji[canonical]...)
+		}
+		components = append(components, component)
+	}
+
+	return components
+}
+
+// Compose joins the components to an emoji sequence. This is the reverse of
+// Decompose and can be used to build emoji such as professions or families.
+// The result isn't checked against EmojiMap, use ContainsEmoji if the
+// result has to be an emoji known to Discord.
+func Compose(components ...Component) string {
+	var emoji strings.Builder
+	for index, component := range components {
+		if index > 0 {
+			emoji.WriteRune(zeroWidthJoiner)
+		}
+		emoji.WriteString(component.Emoji)
+		if component.SkinTone > 0 && component.SkinTone <= 5 {
+			emoji.WriteRune(skinToneBase + rune(component.SkinTone))
+		}
+		if component.VariationSelector {
+			emoji.WriteRune(variationSelector)
+		}
+		if component.Keycap {
+			emoji.WriteRune(keycap)
+		}
+		if component.Tags != "" {
+			for _, r := range component.Tags {
+				emoji.WriteRune(r - ' ' + tagSpace)
+			}
+			emoji.WriteRune(cancelTag)
+		}
+	}
+
+	return emoji.String()
+}
+
+// emojiIndexLookup returns the emoji as found in EmojiMap. Variants with
+// more or fewer variation selectors are found as well.
+func emojiIndexLookup(emoji string) (string, bool) {
+	if _, exists := codesByEmoji[emoji]; exists {
+		return emoji, true
+	}
+	emojiIndexOnce.Do(buildEmojiIndex)
+	canonical, exists := emojiIndex[strings.ReplaceAll(emoji, string(variationSelector), "")]
+	return canonical, exists
+}
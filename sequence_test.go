@@ -0,0 +1,110 @@
+package discordemojimap
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestDecompose(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		emoji string
+		want  []Component
+	}{
+		{name: "empty string", emoji: ""},
+		{
+			name:  "single codepoint",
+			emoji: "😢",
+			want:  []Component{{Emoji: "😢", Codes: []string{"cry"}}},
+		},
+		{
+			name:  "variation selector",
+			emoji: "❤️",
+			want:  []Component{{Emoji: "❤", Codes: []string{"heart"}, VariationSelector: true}},
+		},
+		{
+			name:  "skin tone",
+			emoji: "👍🏽",
+			want:  []Component{{Emoji: "👍", Codes: []string{"thumbsup", "+1", "thumbup"}, SkinTone: 3}},
+		},
+		{
+			name:  "keycap",
+			emoji: "1️⃣",
+			want:  []Component{{Emoji: "1", VariationSelector: true, Keycap: true}},
+		},
+		{
+			name:  "flag",
+			emoji: "🇩🇪",
+			want:  []Component{{Emoji: "🇩🇪", Codes: []string{"flag_de"}}},
+		},
+		{
+			name:  "tag sequence",
+			emoji: GetEmoji("england"),
+			want:  []Component{{Emoji: "🏴", Codes: []string{"flag_black"}, Tags: "gbeng"}},
+		},
+		{
+			name:  "profession with skin tone",
+			emoji: "👩🏽‍💻",
+			want: []Component{
+				{Emoji: "👩", Codes: []string{"woman"}, SkinTone: 3},
+				{Emoji: "💻", Codes: []string{"computer"}},
+			},
+		},
+		{
+			name:  "rainbow flag",
+			emoji: "🏳️‍🌈",
+			want: []Component{
+				{Emoji: "🏳", Codes: []string{"flag_white"}, VariationSelector: true},
+				{Emoji: "🌈", Codes: []string{"rainbow"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Decompose(tt.emoji)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Decompose(%q) = %+v, want %+v", tt.emoji, got, tt.want)
+			}
+			if composed := Compose(got...); composed != tt.emoji {
+				t.Errorf("Compose() = %q, want %q", composed, tt.emoji)
+			}
+		})
+	}
+}
+
+func TestDecomposeAndComposeAllEmoji(t *testing.T) {
+	t.Parallel()
+
+	for code, emoji := range EmojiMap {
+		if composed := Compose(Decompose(emoji)...); composed != emoji {
+			t.Errorf("%s: Compose(Decompose(%q)) = %q", code, emoji, composed)
+		}
+	}
+}
+
+func TestCompose(t *testing.T) {
+	t.Parallel()
+
+	family := Compose(
+		Component{Emoji: "👩"},
+		Component{Emoji: "👩"},
+		Component{Emoji: "👧"},
+	)
+	if got := GetEmojiCodes(family); !reflect.DeepEqual(got, []string{"family_wwg"}) {
+		t.Errorf("GetEmojiCodes(%q) = %v", family, got)
+	}
+}
+
+func ExampleDecompose() {
+	for _, component := range Decompose("👩🏽‍💻") {
+		fmt.Println(component.Codes, component.SkinTone)
+	}
+	// Output:
+	// [woman] 3
+	// [computer] 0
+}
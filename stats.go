@@ -0,0 +1,78 @@
+package discordemojimap
+
+// EmojiStats contains the amount of emoji in a message, as returned by Stats.
+type EmojiStats struct {
+	// Unicode is the amount of unicode emoji.
+	Unicode int
+	// Custom is the amount of custom emoji.
+	Custom int
+	// Codes is the amount of codes that resolve to an emoji.
+	Codes int
+	// UnknownCodes is the amount of sequences that look like codes, such as
+	// ":invalid:", but don't resolve to an emoji.
+	UnknownCodes int
+	// Distinct is the amount of different emoji. A code and the unicode emoji
+	// it resolves to count as the same emoji. Custom emoji are told apart by
+	// their ID.
+	Distinct int
+}
+
+// Total returns the amount of emoji that Discord displays, which is the sum
+// of unicode emoji, custom emoji and resolved codes.
+func (stats EmojiStats) Total() int {
+	return stats.Unicode + stats.Custom + stats.Codes
+}
+
+// Stats counts the emoji in the message. Emoji are found the same way as
+// Tokenize finds them, so ZWJ sequences and emoji with skin tones count as a
+// single emoji.
+func Stats(input string) EmojiStats {
+	var stats EmojiStats
+	distinct := make(map[string]struct{})
+	for _, token := range Tokenize(input) {
+		switch token.Kind {
+		case EmojiToken:
+			stats.Unicode++
+			distinct[token.Emoji] = struct{}{}
+		case CodeToken:
+			stats.Codes++
+			distinct[token.Emoji] = struct{}{}
+		case CustomEmojiToken:
+			stats.Custom++
+			// Prefixed, so IDs can't collide with unicode emoji.
+			distinct["<"+token.Custom.ID] = struct{}{}
+		default:
+			stats.UnknownCodes += countUnknownCodes(token.Text)
+		}
+	}
+	stats.Distinct = len(distinct)
+	return stats
+}
+
+// countUnknownCodes counts sequences such as ":invalid:" in text that
+// contains no resolvable codes. Sequences need to contain at least one
+// letter, so that times such as "10:30:00" aren't counted.
+func countUnknownCodes(text string) int {
+	var count int
+	start := -1
+	var hasLetter bool
+	for index := 0; index < len(text); index++ {
+		c := text[index]
+		switch {
+		case c == ':':
+			if start != -1 && index-start > 1 && hasLetter {
+				count++
+				start = -1
+			} else {
+				start = index
+			}
+			hasLetter = false
+		case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+			hasLetter = true
+		case c == '_' || c == '-' || c == '+' || (c >= '0' && c <= '9'):
+		default:
+			start = -1
+		}
+	}
+	return count
+}
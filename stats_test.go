@@ -0,0 +1,66 @@
+package discordemojimap
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestStats(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  EmojiStats
+	}{
+		{name: "empty string", input: "", want: EmojiStats{}},
+		{name: "text", input: "Hello World", want: EmojiStats{}},
+		{name: "time isn't an unknown code", input: "at 10:30:00", want: EmojiStats{}},
+		{name: "colons with spaces", input: "a: b :c", want: EmojiStats{}},
+		{
+			name:  "unknown codes",
+			input: ":invalid: :also_invalid::nope:",
+			want:  EmojiStats{UnknownCodes: 3},
+		},
+		{
+			name:  "fully-qualified sequence",
+			input: "\U0001f441\ufe0f\u200d\U0001f5e8\ufe0f",
+			want:  EmojiStats{Unicode: 1, Distinct: 1},
+		},
+		{
+			name:  "unicode emoji",
+			input: "😢😢 👍🏻 👩🏽‍💻",
+			want:  EmojiStats{Unicode: 4, Distinct: 3},
+		},
+		{
+			name:  "code and unicode emoji are the same emoji",
+			input: ":cry: 😢 :CRY:",
+			want:  EmojiStats{Unicode: 1, Codes: 2, Distinct: 1},
+		},
+		{
+			name:  "custom emoji",
+			input: "<:pepe:123> <a:pepe:123> <:pepe:456>",
+			want:  EmojiStats{Custom: 3, Distinct: 2},
+		},
+		{
+			name:  "everything",
+			input: "I am :cry: 😢 <:pepe:123> :invalid: 🇩🇪",
+			want:  EmojiStats{Unicode: 2, Custom: 1, Codes: 1, UnknownCodes: 1, Distinct: 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Stats(tt.input); got != tt.want {
+				t.Errorf("Stats(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleStats() {
+	stats := Stats("Hi :wave: 👋🏻 👋 <:pepe:123>")
+	fmt.Println(stats.Total(), stats.Distinct)
+	// Output: 4 3
+}
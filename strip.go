@@ -0,0 +1,81 @@
+package discordemojimap
+
+import "strings"
+
+// textPresentationSelector is the variation selector U+FE0E, requesting
+// text presentation.
+const textPresentationSelector = '\ufe0e'
+
+// StripOptions configures StripEmoji. The zero value removes unicode emoji
+// and custom emoji, but keeps codes.
+type StripOptions struct {
+	// Codes removes codes that resolve to an emoji, such as ":cry:". Codes
+	// that don't resolve to an emoji are always kept.
+	Codes bool
+	// Placeholder replaces every removed emoji. An empty placeholder removes
+	// the emoji without replacement.
+	Placeholder string
+}
+
+// StripEmoji removes all emoji from the input. Unicode emoji are removed as
+// a whole, including ZWJ sequences, skin tones and flags. Stray joiners,
+// variation selectors, skin tone modifiers, keycaps and tags that were part
+// of a removed emoji, but not of an emoji known to Discord, are removed as
+// well. For example:
+//
+//	fmt.Println(StripEmoji("general💬 <:pepe:123>", StripOptions{Placeholder: "_"}))
+//	//Output: general_ _
+//
+// This is useful for systems that can't store emoji, such as some
+// databases.
+func StripEmoji(input string, opts StripOptions) string {
+	var buffer strings.Builder
+	buffer.Grow(len(input))
+	// stripped is true if the previous token was removed, joined is true if
+	// it was also followed by a zero width joiner.
+	var stripped, joined bool
+	for _, token := range Tokenize(input) {
+		if token.Kind == TextToken || (token.Kind == CodeToken && !opts.Codes) {
+			text := token.Text
+			if stripped {
+				text = trimEmojiRemnants(text)
+				if text == "" {
+					joined = strings.HasSuffix(token.Text, string(zeroWidthJoiner))
+					continue
+				}
+			}
+			buffer.WriteString(text)
+			stripped, joined = false, false
+			continue
+		}
+
+		// Emoji joined to a removed emoji form a single emoji unknown to
+		// Discord, so they share the placeholder.
+		if !joined {
+			buffer.WriteString(opts.Placeholder)
+		}
+		stripped, joined = true, false
+	}
+	return buffer.String()
+}
+
+// trimEmojiRemnants removes the leading characters that only modify or join
+// emoji, but aren't visible on their own. If the whole text consists of
+// such characters, an empty string is returned.
+func trimEmojiRemnants(text string) string {
+	for index, r := range text {
+		if !isEmojiRemnant(r) {
+			return text[index:]
+		}
+	}
+	return ""
+}
+
+func isEmojiRemnant(r rune) bool {
+	return r == zeroWidthJoiner ||
+		r == variationSelector ||
+		r == textPresentationSelector ||
+		r == keycap ||
+		(r > skinToneBase && r <= skinToneBase+5) ||
+		(r >= tagSpace && r <= cancelTag)
+}
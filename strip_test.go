@@ -0,0 +1,55 @@
+package discordemojimap
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestStripEmoji(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		opts  StripOptions
+		want  string
+	}{
+		{name: "empty", input: "", want: ""},
+		{name: "no emoji", input: "general", want: "general"},
+		{name: "single emoji", input: "general😢", want: "general"},
+		{name: "zwj sequence", input: "dev👩🏽\u200d💻s", want: "devs"},
+		{name: "flag", input: "🇩🇪news", want: "news"},
+		{name: "keycap", input: "room1\ufe0f\u20e3", want: "room"},
+		{name: "text presentation", input: "Acme© Inc™", want: "Acme© Inc™"},
+		{name: "emoji presentation", input: "Acme©\ufe0f Inc®\ufe0f", want: "Acme Inc"},
+		{name: "subdivision flag", input: "🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F fans", want: " fans"},
+		{name: "custom emoji", input: "hi <a:pepe:123>", want: "hi "},
+		{name: "codes are kept by default", input: ":cry: 😢", want: ":cry: "},
+		{name: "codes", input: ":cry: :invalid: 😢", opts: StripOptions{Codes: true}, want: " :invalid: "},
+		{name: "placeholder", input: "a😢b😢😢c", opts: StripOptions{Placeholder: "?"}, want: "a?b??c"},
+		{
+			// A red haired technologist isn't known to Discord, but both
+			// of its parts are.
+			name:  "unknown zwj sequence",
+			input: "a\U0001F9D1\u200d\U0001F9B0\u200d\U0001F4BBb",
+			opts:  StripOptions{Placeholder: "?"},
+			want:  "a?b",
+		},
+		{name: "stray modifiers", input: "a😢\ufe0f\U0001F3FDb", want: "ab"},
+		{name: "modifiers without emoji are kept", input: "a\u200db", want: "a\u200db"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := StripEmoji(tt.input, tt.opts); got != tt.want {
+				t.Errorf("StripEmoji(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleStripEmoji() {
+	fmt.Println(StripEmoji("general💬 <:pepe:123>", StripOptions{Placeholder: "_"}))
+	// Output: general_ _
+}
@@ -0,0 +1,40 @@
+package discordemojimap
+
+import "strings"
+
+// StripTones removes the skin tones from all emoji in the input, including
+// emoji with multiple skin tones, such as "🫱🏻‍🫲🏿". Codes of emoji with skin
+// tones are replaced with the primary code of the emoji without skin tones.
+// For example:
+//
+//	fmt.Println(StripTones("👍🏻 :thumbsup_tone5:"))
+//	//Output: 👍 :thumbsup:
+//
+// Custom emoji markup and emoji without skin tones are left as they are.
+func StripTones(input string) string {
+	var buffer strings.Builder
+	buffer.Grow(len(input))
+	for _, token := range Tokenize(input) {
+		base, toned := skinToneBases[token.Emoji]
+		switch {
+		case token.Kind == EmojiToken && toned:
+			buffer.WriteString(base)
+		case token.Kind == CodeToken && toned:
+			buffer.WriteByte(':')
+			buffer.WriteString(codesByEmoji[base][0])
+			buffer.WriteByte(':')
+		default:
+			buffer.WriteString(token.Text)
+		}
+	}
+	return buffer.String()
+}
+
+// baseEmoji returns the emoji without skin tones. If the emoji has no skin
+// tones, it is returned as is.
+func baseEmoji(emoji string) string {
+	if base, toned := skinToneBases[emoji]; toned {
+		return base
+	}
+	return emoji
+}
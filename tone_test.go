@@ -0,0 +1,48 @@
+package discordemojimap
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestStripTones(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct{ name, input, want string }{
+		{"empty string", "", ""},
+		{"no emoji", "Hello", "Hello"},
+		{"emoji without skin tone", "👍 😢", "👍 😢"},
+		{"single skin tone", "I agree 👍🏻", "I agree 👍"},
+		{"variation selector is restored", "🏋🏻", "🏋️"},
+		{"zwj sequence", "👩🏽‍💻", "👩‍💻"},
+		{"multiple skin tones", "🫱🏻‍🫲🏿", "🤝"},
+		{"code with skin tone", ":THUMBSUP_TONE5:", ":thumbsup:"},
+		{"code without skin tone", ":+1:", ":+1:"},
+		{"custom emoji", "<:pepe_tone1:123>", "<:pepe_tone1:123>"},
+	}
+	for _, tt := range tests {
+		if got := StripTones(tt.input); got != tt.want {
+			t.Errorf("%s: StripTones(%q) = %q, want %q", tt.name, tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestSkinToneBasesHaveNoSkinTones(t *testing.T) {
+	t.Parallel()
+
+	for toned, base := range skinToneBases {
+		for _, component := range Decompose(base) {
+			if component.SkinTone != 0 {
+				t.Errorf("base %q of %q has a skin tone", base, toned)
+			}
+		}
+		if _, exists := codesByEmoji[base]; !exists {
+			t.Errorf("base %q of %q has no codes", base, toned)
+		}
+	}
+}
+
+func ExampleStripTones() {
+	fmt.Println(StripTones("👍🏻 :thumbsup_tone5:"))
+	// Output: 👍 :thumbsup:
+}
@@ -0,0 +1,62 @@
+package discordemojimap
+
+import (
+	"strconv"
+	"strings"
+)
+
+// These are the default base URLs for Twemoji assets, which Discord uses to
+// render unicode emoji. They can be passed to TwemojiURL.
+const (
+	TwemojiPNGBaseURL = "https://cdn.jsdelivr.net/gh/jdecked/twemoji@15.0.3/assets/72x72/"
+	TwemojiSVGBaseURL = "https://cdn.jsdelivr.net/gh/jdecked/twemoji@15.0.3/assets/svg/"
+)
+
+const (
+	zeroWidthJoiner   = '\u200d'
+	variationSelector = '\ufe0f'
+)
+
+// TwemojiFilename returns the Twemoji asset name of an emoji, without file
+// extension. The name consists of the lowercase hexadecimal codepoints,
+// joined by dashes. For example:
+//
+//	fmt.Println(TwemojiFilename("🏳️‍🌈"))
+//	//Output: 1f3f3-fe0f-200d-1f308
+//
+// Same as Discord, the variation selector U+FE0F is dropped, unless the emoji
+// is a ZWJ sequence. If the emoji is empty, an empty string is returned.
+func TwemojiFilename(emoji string) string {
+	keepVariationSelector := strings.ContainsRune(emoji, zeroWidthJoiner)
+
+	var filename strings.Builder
+	for _, r := range emoji {
+		if r == variationSelector && !keepVariationSelector {
+			continue
+		}
+		if filename.Len() > 0 {
+			filename.WriteByte('-')
+		}
+		filename.WriteString(strconv.FormatInt(int64(r), 16))
+	}
+
+	return filename.String()
+}
+
+// TwemojiURL returns the URL of the Twemoji asset for the given emoji. The
+// extension has to match the base URL, for example "png" for
+// TwemojiPNGBaseURL or "svg" for TwemojiSVGBaseURL. A missing trailing slash
+// in the base URL is added. An empty base URL results in a relative URL,
+// consisting of the filename only. If the emoji is empty, an empty string is
+// returned.
+func TwemojiURL(baseURL, emoji, extension string) string {
+	filename := TwemojiFilename(emoji)
+	if filename == "" {
+		return ""
+	}
+
+	if baseURL != "" && !strings.HasSuffix(baseURL, "/") {
+		baseURL += "/"
+	}
+	return baseURL + filename + "." + extension
+}
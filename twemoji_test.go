@@ -0,0 +1,67 @@
+package discordemojimap
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTwemojiFilename(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		emoji string
+		want  string
+	}{
+		{name: "empty string", emoji: "", want: ""},
+		{name: "single codepoint", emoji: GetEmoji("cry"), want: "1f622"},
+		{name: "variation selector is dropped", emoji: GetEmoji("heart"), want: "2764"},
+		{name: "keycap drops variation selector", emoji: GetEmoji("one"), want: "31-20e3"},
+		{name: "skin tone", emoji: GetEmoji("thumbsup_tone3"), want: "1f44d-1f3fd"},
+		{name: "flag", emoji: GetEmoji("flag_de"), want: "1f1e9-1f1ea"},
+		{name: "zwj sequence keeps variation selector", emoji: GetEmoji("rainbow_flag"), want: "1f3f3-fe0f-200d-1f308"},
+		{name: "zwj sequence without variation selector", emoji: GetEmoji("family_mwgb"), want: "1f468-200d-1f469-200d-1f467-200d-1f466"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := TwemojiFilename(tt.emoji); got != tt.want {
+				t.Errorf("TwemojiFilename(%q) = %q, want %q", tt.emoji, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTwemojiURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		baseURL   string
+		emoji     string
+		extension string
+		want      string
+	}{
+		{name: "empty emoji", baseURL: TwemojiPNGBaseURL, emoji: "", extension: "png", want: ""},
+		{name: "png", baseURL: TwemojiPNGBaseURL, emoji: "😢", extension: "png", want: TwemojiPNGBaseURL + "1f622.png"},
+		{name: "svg", baseURL: TwemojiSVGBaseURL, emoji: "😢", extension: "svg", want: TwemojiSVGBaseURL + "1f622.svg"},
+		{name: "base without trailing slash", baseURL: "/static/emoji", emoji: "😢", extension: "svg", want: "/static/emoji/1f622.svg"},
+		{name: "relative base", baseURL: "", emoji: "😢", extension: "png", want: "1f622.png"},
+		{name: "root base", baseURL: "/", emoji: "😢", extension: "png", want: "/1f622.png"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := TwemojiURL(tt.baseURL, tt.emoji, tt.extension); got != tt.want {
+				t.Errorf("TwemojiURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleTwemojiFilename() {
+	fmt.Println(TwemojiFilename(GetEmoji("rainbow_flag")))
+	// Output: 1f3f3-fe0f-200d-1f308
+}
@@ -0,0 +1,16 @@
+package discordemojimap
+
+// UnicodeVersion returns the emoji version the given emoji was introduced
+// in, for example 14.0 for "🛝" or 13.1 for "😶‍🌫️". This is the version a
+// font has to support in order to display the emoji as a single glyph,
+// instead of falling back to its parts or unknown glyphs. ZWJ sequences
+// have their own version, which is usually newer than the version of their
+// parts. Emoji missing their variation selector are found as well. If the
+// emoji isn't known, 0 is returned.
+func UnicodeVersion(emoji string) float64 {
+	canonical, exists := emojiIndexLookup(emoji)
+	if !exists {
+		return 0
+	}
+	return emojiVersions[canonical]
+}
@@ -0,0 +1,51 @@
+package discordemojimap
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestUnicodeVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		emoji string
+		want  float64
+	}{
+		{name: "empty string", emoji: "", want: 0},
+		{name: "no emoji", emoji: "a", want: 0},
+		{name: "copyright", emoji: "©️", want: 0.6},
+		{name: "heart", emoji: "❤️", want: 0.6},
+		{name: "grinning", emoji: "😀", want: 1.0},
+		{name: "skin tone", emoji: "👍🏻", want: 1.0},
+		{name: "zwj sequence", emoji: "🏳️‍🌈", want: 4.0},
+		{name: "zwj sequence newer than its parts", emoji: GetEmoji("face_in_clouds"), want: 13.1},
+		{name: "zwj sequence with heart", emoji: GetEmoji("heart_on_fire"), want: 13.1},
+		{name: "zwj sequence with animal", emoji: GetEmoji("polar_bear"), want: 13.0},
+		{name: "zwj sequence with person", emoji: GetEmoji("man_technologist"), want: 4.0},
+		{name: "missing variation selector", emoji: "❤", want: 0.6},
+		{name: "more variation selectors than Discord", emoji: "\U0001f441\ufe0f\u200d\U0001f5e8\ufe0f", want: 2.0},
+		{name: "keycap", emoji: "1️⃣", want: 0.6},
+		{name: "flag", emoji: "🇩🇪", want: 0.6},
+		{name: "newer flag", emoji: "🇦🇨", want: 2.0},
+		{name: "tag sequence flag", emoji: GetEmoji("england"), want: 5.0},
+		{name: "hair component", emoji: GetEmoji("person_red_hair"), want: 12.1},
+		{name: "playground slide", emoji: "🛝", want: 14.0},
+		{name: "unknown to Discord", emoji: "🫨", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := UnicodeVersion(tt.emoji); got != tt.want {
+				t.Errorf("UnicodeVersion(%q) = %v, want %v", tt.emoji, got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleUnicodeVersion() {
+	fmt.Println(UnicodeVersion("🛝"))
+	// Output: 14
+}
@@ -0,0 +1,132 @@
+package discordemojimap
+
+import (
+	"sort"
+	"sync"
+)
+
+// Gender is the gender of a person emoji.
+type Gender uint8
+
+const (
+	// GenderNeutral is used by emoji such as "🧑" or "🏃".
+	GenderNeutral Gender = iota
+	// GenderMan is used by emoji such as "👨" or "🏃‍♂️".
+	GenderMan
+	// GenderWoman is used by emoji such as "👩" or "🏃‍♀️".
+	GenderWoman
+)
+
+// HairStyle is the hair style of a person emoji.
+type HairStyle uint8
+
+const (
+	// HairDefault is used by person emoji without a hair component.
+	HairDefault HairStyle = iota
+	// HairRed is used by emoji such as "🧑‍🦰".
+	HairRed
+	// HairCurly is used by emoji such as "🧑‍🦱".
+	HairCurly
+	// HairWhite is used by emoji such as "🧑‍🦳".
+	HairWhite
+	// HairBald is used by emoji such as "🧑‍🦲".
+	HairBald
+)
+
+// Variant is an emoji that only differs from other emoji in gender or hair
+// style.
+type Variant struct {
+	Emoji  string
+	Gender Gender
+	Hair   HairStyle
+}
+
+// personVariant is an entry of the generated personVariants map. All emoji
+// with the same family only differ in gender and hair style.
+type personVariant struct {
+	family string
+	gender Gender
+	hair   HairStyle
+}
+
+var (
+	variantFamiliesOnce sync.Once
+	// variantFamilies contains the variants of all families, sorted by
+	// gender and hair style.
+	variantFamilies map[string][]Variant
+)
+
+func buildVariantFamilies() {
+	variantFamilies = make(map[string][]Variant)
+	for emoji, variant := range personVariants {
+		variantFamilies[variant.family] = append(variantFamilies[variant.family], Variant{
+			Emoji:  emoji,
+			Gender: variant.gender,
+			Hair:   variant.hair,
+		})
+	}
+	for _, variants := range variantFamilies {
+		sort.Slice(variants, func(a, b int) bool {
+			if variants[a].Hair != variants[b].Hair {
+				return variants[a].Hair < variants[b].Hair
+			}
+			return variants[a].Gender < variants[b].Gender
+		})
+	}
+}
+
+// Variants returns all gender and hair style variants of an emoji, including
+// the emoji itself. The variants have the same skin tone as the emoji. For
+// example, the variants of "👨‍🦰" include "🧑", "👩" and "👩‍🦰".
+//
+// If the emoji has no variants, nil is returned.
+func Variants(emoji string) []Variant {
+	canonical, exists := emojiIndexLookup(emoji)
+	if !exists {
+		return nil
+	}
+	variant, exists := personVariants[canonical]
+	if !exists {
+		return nil
+	}
+
+	variantFamiliesOnce.Do(buildVariantFamilies)
+	return append([]Variant(nil), variantFamilies[variant.family]...)
+}
+
+// WithGender returns the variant of the emoji with the given gender, keeping
+// hair style and skin tone. For example, WithGender("🏃", GenderWoman) returns
+// "🏃‍♀️". If there's no such variant, an empty string is returned.
+func WithGender(emoji string, gender Gender) string {
+	return findVariant(emoji, func(current, candidate personVariant) bool {
+		return candidate.gender == gender && candidate.hair == current.hair
+	})
+}
+
+// WithHairStyle returns the variant of the emoji with the given hair style,
+// keeping gender and skin tone. For example, WithHairStyle("👩", HairCurly)
+// returns "👩‍🦱". If there's no such variant, an empty string is returned.
+func WithHairStyle(emoji string, hair HairStyle) string {
+	return findVariant(emoji, func(current, candidate personVariant) bool {
+		return candidate.gender == current.gender && candidate.hair == hair
+	})
+}
+
+func findVariant(emoji string, matches func(current, candidate personVariant) bool) string {
+	canonical, exists := emojiIndexLookup(emoji)
+	if !exists {
+		return ""
+	}
+	current, exists := personVariants[canonical]
+	if !exists {
+		return ""
+	}
+
+	variantFamiliesOnce.Do(buildVariantFamilies)
+	for _, variant := range variantFamilies[current.family] {
+		if matches(current, personVariant{family: current.family, gender: variant.Gender, hair: variant.Hair}) {
+			return variant.Emoji
+		}
+	}
+	return ""
+}
@@ -0,0 +1,100 @@
+package discordemojimap
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestVariants(t *testing.T) {
+	t.Parallel()
+
+	if got := Variants(""); got != nil {
+		t.Errorf("Variants(\"\") = %v, want nil", got)
+	}
+	if got := Variants("😢"); got != nil {
+		t.Errorf("Variants(\"😢\") = %v, want nil", got)
+	}
+
+	want := []Variant{
+		{Emoji: "🏃", Gender: GenderNeutral},
+		{Emoji: "🏃‍♂️", Gender: GenderMan},
+		{Emoji: "🏃‍♀️", Gender: GenderWoman},
+	}
+	for _, emoji := range []string{"🏃", "🏃‍♂️", "🏃‍♀️", "🏃‍♂"} {
+		if got := Variants(emoji); fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("Variants(%q) = %v, want %v", emoji, got, want)
+		}
+	}
+
+	variants := Variants(GetEmoji("man_red_haired_tone2"))
+	if len(variants) != 15 {
+		t.Fatalf("Variants() returned %d variants, want 15: %v", len(variants), variants)
+	}
+	for _, variant := range variants {
+		if Decompose(variant.Emoji)[0].SkinTone != 2 {
+			t.Errorf("Variant %q doesn't keep the skin tone", variant.Emoji)
+		}
+	}
+}
+
+func TestWithGender(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		emoji  string
+		gender Gender
+		want   string
+	}{
+		{name: "no variants", emoji: "😢", gender: GenderWoman, want: ""},
+		{name: "gender sign", emoji: "🏃", gender: GenderWoman, want: "🏃‍♀️"},
+		{name: "gender sign to neutral", emoji: "🏃‍♂️", gender: GenderNeutral, want: "🏃"},
+		{name: "gender sign with variation selector", emoji: "🏋️", gender: GenderMan, want: "🏋️‍♂️"},
+		{name: "gender sign with skin tone", emoji: "🏋🏻", gender: GenderMan, want: "🏋🏻‍♂️"},
+		{name: "person", emoji: "🧑", gender: GenderMan, want: "👨"},
+		{name: "profession", emoji: "👩‍⚕️", gender: GenderNeutral, want: "🧑‍⚕️"},
+		{name: "profession with skin tone", emoji: "👩🏽‍💻", gender: GenderMan, want: "👨🏽‍💻"},
+		{name: "hair style is kept", emoji: "👩‍🦱", gender: GenderMan, want: "👨‍🦱"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := WithGender(tt.emoji, tt.gender); got != tt.want {
+				t.Errorf("WithGender(%q, %d) = %q, want %q", tt.emoji, tt.gender, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithHairStyle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		emoji string
+		hair  HairStyle
+		want  string
+	}{
+		{name: "no variants", emoji: "😢", hair: HairRed, want: ""},
+		{name: "no hair variants", emoji: "🏃", hair: HairRed, want: ""},
+		{name: "person", emoji: "🧑", hair: HairCurly, want: "🧑‍🦱"},
+		{name: "woman", emoji: "👩", hair: HairWhite, want: "👩‍🦳"},
+		{name: "back to default", emoji: "👨‍🦲", hair: HairDefault, want: "👨"},
+		{name: "skin tone is kept", emoji: "👨🏿", hair: HairBald, want: "👨🏿‍🦲"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := WithHairStyle(tt.emoji, tt.hair); got != tt.want {
+				t.Errorf("WithHairStyle(%q, %d) = %q, want %q", tt.emoji, tt.hair, got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleWithGender() {
+	fmt.Println(WithGender("🏃", GenderWoman))
+	// Output: 🏃‍♀️
+}
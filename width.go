@@ -0,0 +1,119 @@
+package discordemojimap
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Width returns the amount of columns the input occupies in a terminal.
+//
+// Every emoji from EmojiMap counts as a single cluster with a width of two,
+// no matter how many codepoints it consists of. This includes ZWJ sequences,
+// flags, keycaps and emoji with skin tones. Other characters are one column
+// wide, unless they are East Asian wide characters, which are two columns
+// wide, or combining and control characters, which don't occupy a column.
+//
+// Characters that default to text presentation, such as "❤", count as one
+// column, unless they're followed by the variation selector U+FE0F.
+func Width(input string) int {
+	var width int
+	for index := 0; index < len(input); {
+		if _, length := matchEmoji(input[index:]); length > 0 {
+			width += 2
+			index += length
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(input[index:])
+		width += runeWidth(r)
+		index += size
+	}
+
+	return width
+}
+
+// wideRanges contains the ranges of East Asian wide and fullwidth characters
+// and emoji with emoji presentation by default.
+var wideRanges = [][2]rune{
+	{0x1100, 0x115F},
+	{0x231A, 0x231B},
+	{0x2329, 0x232A},
+	{0x23E9, 0x23EC},
+	{0x23F0, 0x23F0},
+	{0x23F3, 0x23F3},
+	{0x25FD, 0x25FE},
+	{0x2614, 0x2615},
+	{0x2648, 0x2653},
+	{0x267F, 0x267F},
+	{0x2693, 0x2693},
+	{0x26A1, 0x26A1},
+	{0x26AA, 0x26AB},
+	{0x26BD, 0x26BE},
+	{0x26C4, 0x26C5},
+	{0x26CE, 0x26CE},
+	{0x26D4, 0x26D4},
+	{0x26EA, 0x26EA},
+	{0x26F2, 0x26F3},
+	{0x26F5, 0x26F5},
+	{0x26FA, 0x26FA},
+	{0x26FD, 0x26FD},
+	{0x2705, 0x2705},
+	{0x270A, 0x270B},
+	{0x2728, 0x2728},
+	{0x274C, 0x274C},
+	{0x274E, 0x274E},
+	{0x2753, 0x2755},
+	{0x2757, 0x2757},
+	{0x2795, 0x2797},
+	{0x27B0, 0x27B0},
+	{0x27BF, 0x27BF},
+	{0x2B1B, 0x2B1C},
+	{0x2B50, 0x2B50},
+	{0x2B55, 0x2B55},
+	{0x2E80, 0x303E},
+	{0x3041, 0x33FF},
+	{0x3400, 0x4DBF},
+	{0x4E00, 0x9FFF},
+	{0xA000, 0xA4CF},
+	{0xA960, 0xA97F},
+	{0xAC00, 0xD7A3},
+	{0xF900, 0xFAFF},
+	{0xFE10, 0xFE19},
+	{0xFE30, 0xFE6F},
+	{0xFF00, 0xFF60},
+	{0xFFE0, 0xFFE6},
+	{0x16FE0, 0x18CFF},
+	{0x1B000, 0x1B2FF},
+	{0x1F004, 0x1F004},
+	{0x1F0CF, 0x1F0CF},
+	{0x1F18E, 0x1F18E},
+	{0x1F191, 0x1F19A},
+	{0x1F200, 0x1F251},
+	{0x1F300, 0x1F64F},
+	{0x1F680, 0x1F6FF},
+	{0x1F7E0, 0x1F7F0},
+	{0x1F900, 0x1FAFF},
+	{0x20000, 0x3FFFD},
+}
+
+func runeWidth(r rune) int {
+	if r < 0x20 || (r >= 0x7F && r < 0xA0) {
+		return 0
+	}
+	if unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf) {
+		return 0
+	}
+	if r < wideRanges[0][0] {
+		return 1
+	}
+
+	for _, wide := range wideRanges {
+		if r < wide[0] {
+			break
+		}
+		if r <= wide[1] {
+			return 2
+		}
+	}
+	return 1
+}
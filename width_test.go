@@ -0,0 +1,49 @@
+package discordemojimap
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestWidth(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{name: "empty string", input: "", want: 0},
+		{name: "ascii", input: "Hello", want: 5},
+		{name: "fully-qualified sequence", input: "\U0001f441\ufe0f\u200d\U0001f5e8\ufe0f", want: 2},
+		{name: "control characters", input: "a\tb\n", want: 2},
+		{name: "combining character", input: "é", want: 1},
+		{name: "east asian wide", input: "世界", want: 4},
+		{name: "fullwidth", input: "ＡＢ", want: 4},
+		{name: "single codepoint emoji", input: "😢", want: 2},
+		{name: "emoji with variation selector", input: "❤️", want: 2},
+		{name: "text presentation", input: "❤", want: 1},
+		{name: "skin tone", input: "👍🏽", want: 2},
+		{name: "multiple skin tones", input: GetEmoji("handshake_tone1_tone5"), want: 2},
+		{name: "zwj sequence", input: "👨‍👩‍👧‍👦", want: 2},
+		{name: "zwj sequence with skin tone", input: GetEmoji("man_technologist_tone3"), want: 2},
+		{name: "flag", input: "🇩🇪", want: 2},
+		{name: "tag sequence flag", input: GetEmoji("england"), want: 2},
+		{name: "keycap", input: "1️⃣", want: 2},
+		{name: "mixed", input: "I am 😢 :cry: 🏳️‍🌈!", want: 17},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Width(tt.input); got != tt.want {
+				t.Errorf("Width(%q) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleWidth() {
+	fmt.Println(Width("👨‍👩‍👧‍👦 family"))
+	// Output: 9
+}